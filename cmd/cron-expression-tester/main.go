@@ -7,7 +7,9 @@ package main
 import (
 	"context"
 	"os"
+	"time"
 
+	"github.com/bborbe/errors"
 	"github.com/bborbe/run"
 	libsentry "github.com/bborbe/sentry"
 	"github.com/bborbe/service"
@@ -28,8 +30,21 @@ type application struct {
 }
 
 func (a *application) Run(ctx context.Context, sentryClient libsentry.Client) error {
+	expression := cron.Expression(a.CronExpression)
+	description, err := expression.Describe(ctx)
+	if err != nil {
+		return errors.Wrap(ctx, err, "describe cron expression failed")
+	}
+	glog.V(2).Infof("cron expression '%s' runs %s", expression, description)
+	nextRuns, err := expression.NextN(ctx, nil, time.Now(), 5)
+	if err != nil {
+		return errors.Wrap(ctx, err, "calculate next runs failed")
+	}
+	for _, nextRun := range nextRuns {
+		glog.V(2).Infof("next run at %s", nextRun.Format(time.RFC3339))
+	}
 	return cron.NewExpressionCron(
-		cron.Expression(a.CronExpression),
+		expression,
 		run.Func(func(ctx context.Context) error {
 			glog.V(2).Infof("cron executed")
 			return nil
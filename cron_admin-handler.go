@@ -0,0 +1,135 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package cron
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/bborbe/errors"
+)
+
+// NewAdminHandler returns an http.Handler to inspect and control the jobs of a Scheduler.
+// It serves the following routes relative to where it is mounted:
+//
+//	GET  /jobs                 lists all jobs with their state as JSON
+//	GET  /jobs/{name}          returns a single job as JSON
+//	GET  /jobs/{name}/history  returns the recent executions of a job as JSON, oldest first
+//	POST /jobs/{name}/trigger  starts an execution in the background
+//	POST /jobs/{name}/pause    pauses scheduled executions
+//	POST /jobs/{name}/resume   resumes scheduled executions
+//
+// Mount it below a prefix with http.StripPrefix, e.g. next to the metrics handler.
+func NewAdminHandler(scheduler Scheduler) http.Handler {
+	h := &adminHandler{
+		scheduler: scheduler,
+	}
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /jobs", h.list)
+	mux.HandleFunc("GET /jobs/{name}", h.get)
+	mux.HandleFunc("GET /jobs/{name}/history", h.history)
+	mux.HandleFunc("POST /jobs/{name}/trigger", h.trigger)
+	mux.HandleFunc("POST /jobs/{name}/pause", h.pause)
+	mux.HandleFunc("POST /jobs/{name}/resume", h.resume)
+	return mux
+}
+
+type adminHandler struct {
+	scheduler Scheduler
+}
+
+func (h *adminHandler) list(resp http.ResponseWriter, req *http.Request) {
+	writeJSON(req.Context(), resp, http.StatusOK, h.scheduler.Jobs())
+}
+
+func (h *adminHandler) get(resp http.ResponseWriter, req *http.Request) {
+	name := req.PathValue("name")
+	for _, job := range h.scheduler.Jobs() {
+		if job.Name == name {
+			writeJSON(req.Context(), resp, http.StatusOK, job)
+			return
+		}
+	}
+	writeError(req.Context(), resp, http.StatusNotFound, "job '"+name+"' not found")
+}
+
+func (h *adminHandler) history(resp http.ResponseWriter, req *http.Request) {
+	name := req.PathValue("name")
+	history, err := h.scheduler.History(req.Context(), name)
+	if err != nil {
+		writeError(req.Context(), resp, http.StatusNotFound, err.Error())
+		return
+	}
+	writeJSON(req.Context(), resp, http.StatusOK, history.Executions())
+}
+
+func (h *adminHandler) trigger(resp http.ResponseWriter, req *http.Request) {
+	controller, ok := h.controller(resp, req)
+	if !ok {
+		return
+	}
+	name := req.PathValue("name")
+	// the execution outlives the request, its result is available via the job state
+	if err := h.scheduler.Trigger(req.Context(), name); err != nil {
+		writeSchedulerError(req.Context(), resp, err)
+		return
+	}
+	jobLogger(req.Context(), name).InfoContext(req.Context(), "job triggered via admin handler")
+	writeJSON(req.Context(), resp, http.StatusAccepted, controller.State())
+}
+
+func (h *adminHandler) pause(resp http.ResponseWriter, req *http.Request) {
+	controller, ok := h.controller(resp, req)
+	if !ok {
+		return
+	}
+	controller.Pause()
+	jobLogger(req.Context(), req.PathValue("name")).InfoContext(req.Context(), "job paused via admin handler")
+	writeJSON(req.Context(), resp, http.StatusOK, controller.State())
+}
+
+func (h *adminHandler) resume(resp http.ResponseWriter, req *http.Request) {
+	controller, ok := h.controller(resp, req)
+	if !ok {
+		return
+	}
+	controller.Resume()
+	jobLogger(req.Context(), req.PathValue("name")).InfoContext(req.Context(), "job resumed via admin handler")
+	writeJSON(req.Context(), resp, http.StatusOK, controller.State())
+}
+
+// controller looks up the Controller of the job in the path and writes an error response if there is none.
+func (h *adminHandler) controller(resp http.ResponseWriter, req *http.Request) (Controller, bool) {
+	controller, err := h.scheduler.Controller(req.Context(), req.PathValue("name"))
+	if err != nil {
+		writeSchedulerError(req.Context(), resp, err)
+		return nil, false
+	}
+	return controller, true
+}
+
+// writeSchedulerError writes not found for ErrJobNotFound and conflict for other errors of the scheduler.
+func writeSchedulerError(ctx context.Context, resp http.ResponseWriter, err error) {
+	if errors.Is(err, ErrJobNotFound) {
+		writeError(ctx, resp, http.StatusNotFound, err.Error())
+		return
+	}
+	writeError(ctx, resp, http.StatusConflict, err.Error())
+}
+
+func writeError(ctx context.Context, resp http.ResponseWriter, statusCode int, message string) {
+	writeJSON(ctx, resp, statusCode, map[string]string{
+		"error": message,
+	})
+}
+
+func writeJSON(ctx context.Context, resp http.ResponseWriter, statusCode int, value any) {
+	resp.Header().Set("Content-Type", "application/json")
+	resp.WriteHeader(statusCode)
+	if err := json.NewEncoder(resp).Encode(value); err != nil {
+		LoggerFromContext(ctx).WarnContext(ctx, "write json response failed", LogKeyError, err)
+	}
+}
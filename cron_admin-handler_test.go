@@ -0,0 +1,112 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package cron_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"time"
+
+	"github.com/bborbe/run"
+	libtime "github.com/bborbe/time"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/bborbe/cron"
+)
+
+var _ = Describe("AdminHandler", func() {
+	var ctx context.Context
+	var cancel context.CancelFunc
+	var clock *cron.FakeClock
+	var scheduler cron.Scheduler
+	var server *httptest.Server
+	var counter int64
+
+	// request sends a request to the handler and decodes the JSON response into value.
+	request := func(method string, path string, value any) int {
+		req, err := http.NewRequestWithContext(ctx, method, server.URL+path, nil)
+		Expect(err).To(BeNil())
+		resp, err := http.DefaultClient.Do(req)
+		Expect(err).To(BeNil())
+		defer resp.Body.Close()
+		if value != nil {
+			Expect(resp.Header.Get("Content-Type")).To(Equal("application/json"))
+			Expect(json.NewDecoder(resp.Body).Decode(value)).To(Succeed())
+		}
+		return resp.StatusCode
+	}
+
+	BeforeEach(func() {
+		ctx, cancel = context.WithCancel(context.Background())
+		clock = cron.NewFakeClock(time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC))
+		atomic.StoreInt64(&counter, 0)
+		scheduler = cron.NewScheduler()
+		action := run.Func(func(ctx context.Context) error {
+			atomic.AddInt64(&counter, 1)
+			return nil
+		})
+		Expect(scheduler.Add(ctx, cron.Job{
+			Name:       "expression-job",
+			Expression: "0 * * * * ?",
+			Action:     action,
+			Options:    cron.Options{Clock: clock},
+		})).To(Succeed())
+		Expect(scheduler.Add(ctx, cron.Job{
+			Name:    "interval-job",
+			Wait:    libtime.Hour,
+			Action:  action,
+			Options: cron.Options{Clock: clock},
+		})).To(Succeed())
+		go func() {
+			_ = scheduler.Run(ctx)
+		}()
+		Expect(clock.WaitForTimers(ctx, 2)).To(Succeed())
+		server = httptest.NewServer(http.StripPrefix("/admin", cron.NewAdminHandler(scheduler)))
+	})
+	AfterEach(func() {
+		server.Close()
+		cancel()
+	})
+	It("lists all jobs", func() {
+		var jobs []cron.JobInfo
+		Expect(request(http.MethodGet, "/admin/jobs", &jobs)).To(Equal(http.StatusOK))
+		Expect(jobs).To(HaveLen(2))
+		Expect(jobs[0].Name).To(Equal("expression-job"))
+		Expect(jobs[0].Expression).To(Equal(cron.Expression("0 * * * * ?")))
+		Expect(jobs[0].NextRun).To(BeTemporally("==", time.Date(2026, 1, 1, 12, 1, 0, 0, time.UTC)))
+		Expect(jobs[1].Name).To(Equal("interval-job"))
+		Expect(jobs[1].Interval).To(Equal("1h0m0s"))
+		Expect(jobs[1].LastRun).To(BeTemporally("==", time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)))
+		Expect(jobs[1].NextRun).To(BeTemporally("==", time.Date(2026, 1, 1, 13, 0, 0, 0, time.UTC)))
+	})
+	It("returns a single job", func() {
+		var job cron.JobInfo
+		Expect(request(http.MethodGet, "/admin/jobs/interval-job", &job)).To(Equal(http.StatusOK))
+		Expect(job.Name).To(Equal("interval-job"))
+	})
+	It("returns not found for unknown jobs", func() {
+		Expect(request(http.MethodGet, "/admin/jobs/unknown", nil)).To(Equal(http.StatusNotFound))
+		Expect(request(http.MethodPost, "/admin/jobs/unknown/pause", nil)).To(Equal(http.StatusNotFound))
+		Expect(request(http.MethodPost, "/admin/jobs/unknown/trigger", nil)).To(Equal(http.StatusNotFound))
+	})
+	It("pauses and resumes a job", func() {
+		var state cron.JobState
+		Expect(request(http.MethodPost, "/admin/jobs/interval-job/pause", &state)).To(Equal(http.StatusOK))
+		Expect(state.Paused).To(BeTrue())
+		Expect(request(http.MethodPost, "/admin/jobs/interval-job/resume", &state)).To(Equal(http.StatusOK))
+		Expect(state.Paused).To(BeFalse())
+	})
+	It("triggers a job", func() {
+		Expect(request(http.MethodPost, "/admin/jobs/expression-job/trigger", nil)).To(Equal(http.StatusAccepted))
+		Eventually(func() int64 { return atomic.LoadInt64(&counter) }).Should(Equal(int64(2)))
+	})
+	It("rejects other methods", func() {
+		Expect(request(http.MethodGet, "/admin/jobs/interval-job/trigger", nil)).To(Equal(http.StatusMethodNotAllowed))
+	})
+})
@@ -0,0 +1,129 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package cron
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// NewFakeClock returns a Clock whose time only moves when Add is called.
+// Timers fire as soon as the fake time reaches their deadline.
+func NewFakeClock(now time.Time) *FakeClock {
+	return &FakeClock{
+		now:     now,
+		changed: make(chan struct{}),
+	}
+}
+
+// FakeClock is a manually advanced Clock for deterministic tests.
+type FakeClock struct {
+	mux     sync.Mutex
+	now     time.Time
+	timers  []*fakeTimer
+	changed chan struct{}
+}
+
+// Now returns the current fake time.
+func (f *FakeClock) Now() time.Time {
+	f.mux.Lock()
+	defer f.mux.Unlock()
+	return f.now
+}
+
+// NewTimer creates a timer that fires once the fake time advanced by d.
+func (f *FakeClock) NewTimer(d time.Duration) Timer {
+	f.mux.Lock()
+	defer f.mux.Unlock()
+	t := &fakeTimer{
+		clock:    f,
+		deadline: f.now.Add(d),
+		c:        make(chan time.Time, 1),
+	}
+	if d <= 0 {
+		t.c <- f.now
+		return t
+	}
+	f.timers = append(f.timers, t)
+	f.notify()
+	return t
+}
+
+// Add advances the fake time by d and fires all timers whose deadline has been reached.
+func (f *FakeClock) Add(d time.Duration) {
+	f.mux.Lock()
+	defer f.mux.Unlock()
+	f.now = f.now.Add(d)
+	pending := f.timers[:0]
+	for _, t := range f.timers {
+		if t.deadline.After(f.now) {
+			pending = append(pending, t)
+			continue
+		}
+		t.c <- f.now
+	}
+	f.timers = pending
+	f.notify()
+}
+
+// Timers returns the number of timers waiting to fire.
+func (f *FakeClock) Timers() int {
+	f.mux.Lock()
+	defer f.mux.Unlock()
+	return len(f.timers)
+}
+
+// WaitForTimers blocks until at least n timers are waiting to fire or the context is done.
+// Use it to make sure a scheduler reached its next wait before calling Add.
+func (f *FakeClock) WaitForTimers(ctx context.Context, n int) error {
+	for {
+		f.mux.Lock()
+		if len(f.timers) >= n {
+			f.mux.Unlock()
+			return nil
+		}
+		changed := f.changed
+		f.mux.Unlock()
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-changed:
+		}
+	}
+}
+
+func (f *FakeClock) stop(t *fakeTimer) bool {
+	f.mux.Lock()
+	defer f.mux.Unlock()
+	for i, pending := range f.timers {
+		if pending == t {
+			f.timers = append(f.timers[:i], f.timers[i+1:]...)
+			f.notify()
+			return true
+		}
+	}
+	return false
+}
+
+// notify wakes up all WaitForTimers callers; the caller must hold the lock.
+func (f *FakeClock) notify() {
+	close(f.changed)
+	f.changed = make(chan struct{})
+}
+
+type fakeTimer struct {
+	clock    *FakeClock
+	deadline time.Time
+	c        chan time.Time
+}
+
+func (t *fakeTimer) C() <-chan time.Time {
+	return t.c
+}
+
+func (t *fakeTimer) Stop() bool {
+	return t.clock.stop(t)
+}
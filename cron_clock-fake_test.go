@@ -0,0 +1,80 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package cron_test
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/bborbe/cron"
+)
+
+var _ = Describe("FakeClock", func() {
+	var ctx context.Context
+	var now time.Time
+	var clock *cron.FakeClock
+	BeforeEach(func() {
+		ctx = context.Background()
+		now = time.Date(2026, 8, 13, 12, 0, 0, 0, time.UTC)
+		clock = cron.NewFakeClock(now)
+	})
+	It("returns the initial time", func() {
+		Expect(clock.Now()).To(Equal(now))
+	})
+	It("advances the time", func() {
+		clock.Add(time.Minute)
+		Expect(clock.Now()).To(Equal(now.Add(time.Minute)))
+	})
+	Context("timer", func() {
+		var timer cron.Timer
+		BeforeEach(func() {
+			timer = clock.NewTimer(time.Minute)
+		})
+		It("is pending", func() {
+			Expect(clock.Timers()).To(Equal(1))
+		})
+		It("does not fire before the deadline", func() {
+			clock.Add(59 * time.Second)
+			Expect(timer.C()).NotTo(Receive())
+			Expect(clock.Timers()).To(Equal(1))
+		})
+		It("fires at the deadline", func() {
+			clock.Add(time.Minute)
+			Expect(timer.C()).To(Receive(Equal(now.Add(time.Minute))))
+			Expect(clock.Timers()).To(Equal(0))
+		})
+		It("does not fire after stop", func() {
+			Expect(timer.Stop()).To(BeTrue())
+			clock.Add(time.Hour)
+			Expect(timer.C()).NotTo(Receive())
+		})
+		It("returns false on stop after fire", func() {
+			clock.Add(time.Minute)
+			Expect(timer.Stop()).To(BeFalse())
+		})
+	})
+	It("fires a timer without duration immediately", func() {
+		timer := clock.NewTimer(0)
+		Expect(timer.C()).To(Receive(Equal(now)))
+		Expect(clock.Timers()).To(Equal(0))
+	})
+	Context("WaitForTimers", func() {
+		It("returns once a timer is created", func() {
+			go func() {
+				time.Sleep(10 * time.Millisecond)
+				clock.NewTimer(time.Minute)
+			}()
+			Expect(clock.WaitForTimers(ctx, 1)).To(Succeed())
+		})
+		It("returns error if context is cancelled", func() {
+			ctx, cancel := context.WithCancel(ctx)
+			cancel()
+			Expect(clock.WaitForTimers(ctx, 1)).To(MatchError(context.Canceled))
+		})
+	})
+})
@@ -0,0 +1,62 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package cron
+
+import (
+	"time"
+
+	libtime "github.com/bborbe/time"
+)
+
+//counterfeiter:generate -o mocks/cron-clock.go --fake-name CronClock . Clock
+
+// Clock provides the current time and timers used by the schedulers.
+// Inject a fake implementation to drive schedules in tests without sleeping.
+type Clock interface {
+	// Now returns the current time.
+	Now() time.Time
+	// NewTimer creates a timer that fires once after the given duration.
+	NewTimer(d time.Duration) Timer
+}
+
+//counterfeiter:generate -o mocks/cron-timer.go --fake-name CronTimer . Timer
+
+// Timer is a single-shot timer created by a Clock.
+type Timer interface {
+	// C returns the channel on which the time is delivered when the timer fires.
+	C() <-chan time.Time
+	// Stop prevents the timer from firing. It returns false if the timer already fired or was stopped.
+	Stop() bool
+}
+
+// NewClock returns a Clock backed by real timers.
+// The current time is read from libtime.Now, so replacing it affects the schedulers as well.
+func NewClock() Clock {
+	return &clock{}
+}
+
+type clock struct{}
+
+func (c *clock) Now() time.Time {
+	return libtime.Now()
+}
+
+func (c *clock) NewTimer(d time.Duration) Timer {
+	return &timer{
+		timer: time.NewTimer(d),
+	}
+}
+
+type timer struct {
+	timer *time.Timer
+}
+
+func (t *timer) C() <-chan time.Time {
+	return t.timer.C
+}
+
+func (t *timer) Stop() bool {
+	return t.timer.Stop()
+}
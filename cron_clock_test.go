@@ -0,0 +1,47 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package cron_test
+
+import (
+	"time"
+
+	libtime "github.com/bborbe/time"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/bborbe/cron"
+)
+
+var _ = Describe("Clock", func() {
+	var clock cron.Clock
+	BeforeEach(func() {
+		clock = cron.NewClock()
+	})
+	Context("Now", func() {
+		var originalNow func() time.Time
+		BeforeEach(func() {
+			originalNow = libtime.Now
+		})
+		AfterEach(func() {
+			libtime.Now = originalNow
+		})
+		It("reads the libtime clock", func() {
+			now := time.Date(2026, 8, 13, 12, 0, 0, 0, time.UTC)
+			libtime.Now = func() time.Time { return now }
+			Expect(clock.Now()).To(Equal(now))
+		})
+	})
+	Context("NewTimer", func() {
+		It("fires after the duration", func() {
+			timer := clock.NewTimer(time.Millisecond)
+			Eventually(timer.C()).Should(Receive())
+		})
+		It("does not fire after stop", func() {
+			timer := clock.NewTimer(time.Hour)
+			Expect(timer.Stop()).To(BeTrue())
+			Consistently(timer.C(), 50*time.Millisecond).ShouldNot(Receive())
+		})
+	})
+})
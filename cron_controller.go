@@ -0,0 +1,172 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package cron
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/bborbe/run"
+)
+
+//counterfeiter:generate -o mocks/cron-controller.go --fake-name CronController . Controller
+
+// Controller allows to pause, resume and manually trigger a recurring cron.
+// Interval and expression crons implement it, a Scheduler returns it by job name.
+type Controller interface {
+	// Pause skips scheduled executions until Resume is called. Running executions are not cancelled.
+	Pause()
+	// Resume continues scheduled executions after Pause.
+	Resume()
+	// TriggerNow runs the action immediately and returns its result.
+	// It applies the same option wrappers as scheduled executions, including the overlap policy,
+	// and runs even if the cron is paused.
+	TriggerNow(ctx context.Context) error
+	// State returns the current state of the cron.
+	State() JobState
+}
+
+// JobState describes the current state of a cron.
+type JobState struct {
+	// Paused is true if scheduled executions are skipped.
+	Paused bool `json:"paused"`
+	// Running is true if at least one execution is in progress.
+	Running bool `json:"running"`
+	// LastRun is the start time of the last completed execution. Zero if it never ran.
+	LastRun time.Time `json:"lastRun,omitzero"`
+	// LastError is the error message of the last completed execution. Empty if it succeeded.
+	LastError string `json:"lastError,omitempty"`
+	// NextRun is the time of the next scheduled execution. Zero if the cron is not running.
+	NextRun time.Time `json:"nextRun,omitzero"`
+}
+
+func newJobControl(
+	options Options,
+	schedule string,
+	clock Clock,
+	action run.Runnable,
+) *jobControl {
+	return &jobControl{
+		name:     options.Name,
+		schedule: schedule,
+		clock:    clock,
+		metrics:  options.enabledMetrics(),
+		logger:   options.Logger,
+		listener: options.listenerOrDefault(),
+		action:   action,
+	}
+}
+
+// jobControl implements Controller for the recurring crons.
+type jobControl struct {
+	name     string
+	schedule string
+	clock    Clock
+	metrics  ExtendedMetrics
+	logger   *slog.Logger
+	listener Listener
+	action   run.Runnable
+	paused   atomic.Bool
+	running  atomic.Int64
+
+	mux       sync.Mutex
+	lastRun   time.Time
+	lastError error
+	nextRun   time.Time
+}
+
+func (c *jobControl) Pause() {
+	c.paused.Store(true)
+}
+
+func (c *jobControl) Resume() {
+	c.paused.Store(false)
+}
+
+func (c *jobControl) TriggerNow(ctx context.Context) error {
+	ctx = c.withLogger(ctx)
+	LoggerFromContext(ctx).InfoContext(ctx, "trigger cron action manually")
+	return c.execute(ctx)
+}
+
+func (c *jobControl) State() JobState {
+	c.mux.Lock()
+	defer c.mux.Unlock()
+	state := JobState{
+		Paused:  c.paused.Load(),
+		Running: c.running.Load() > 0,
+		LastRun: c.lastRun,
+		NextRun: c.nextRun,
+	}
+	if c.lastError != nil {
+		state.LastError = c.lastError.Error()
+	}
+	return state
+}
+
+// execute runs the action with its ExecutionInfo, tracks it as running and records its result.
+func (c *jobControl) execute(ctx context.Context) error {
+	c.running.Add(1)
+	defer c.running.Add(-1)
+	start := c.clock.Now()
+	ctx = withExecutionInfo(ctx, c.name, c.schedule, start)
+	logger := LoggerFromContext(ctx)
+	logger.Log(ctx, LevelTrace, "run cron action started")
+	err := c.action.Run(ctx)
+	if err != nil {
+		logger.DebugContext(ctx, "run cron action failed", LogKeyDuration, c.clock.Now().Sub(start), LogKeyError, err)
+	} else {
+		logger.Log(ctx, LevelTrace, "run cron action completed", LogKeyDuration, c.clock.Now().Sub(start))
+	}
+	c.mux.Lock()
+	defer c.mux.Unlock()
+	c.lastRun = start
+	c.lastError = err
+	return err
+}
+
+// setNextRun records the time of the next scheduled execution.
+func (c *jobControl) setNextRun(nextRun time.Time) {
+	c.mux.Lock()
+	defer c.mux.Unlock()
+	c.nextRun = nextRun
+	c.metrics.SetNextRun(c.name, nextRun)
+}
+
+// scheduled records the time of the next scheduled execution and reports it to the listener.
+func (c *jobControl) scheduled(ctx context.Context, nextRun time.Time) {
+	c.setNextRun(nextRun)
+	c.listener.OnScheduled(ctx, c.name, nextRun)
+}
+
+// shutdown clears the next run and reports the stopped cron to the listener.
+func (c *jobControl) shutdown(ctx context.Context) {
+	c.setNextRun(time.Time{})
+	c.listener.OnShutdown(context.WithoutCancel(ctx), c.name)
+}
+
+// withLogger returns a context whose logger carries the job name.
+// It is applied by the entry points Run and TriggerNow and prefers Options.Logger
+// over the logger of the context.
+func (c *jobControl) withLogger(ctx context.Context) context.Context {
+	return withJobLogger(ctx, c.logger, c.name)
+}
+
+// skipIfPaused wraps a scheduled execution so it does nothing while paused.
+func (c *jobControl) skipIfPaused(fn run.Runnable) run.Runnable {
+	return run.Func(func(ctx context.Context) error {
+		if c.paused.Load() {
+			LoggerFromContext(ctx).DebugContext(ctx, "cron is paused, skip execution")
+			executionInfo := currentExecution(ctx, c.name, c.clock)
+			executionInfo.Schedule = c.schedule
+			c.listener.OnSkip(ctx, executionInfo, SkipReasonPaused)
+			return nil
+		}
+		return fn.Run(ctx)
+	})
+}
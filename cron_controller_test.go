@@ -0,0 +1,212 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package cron_test
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"time"
+
+	"github.com/bborbe/run"
+	libtime "github.com/bborbe/time"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/bborbe/cron"
+	"github.com/bborbe/cron/mocks"
+)
+
+var _ = Describe("Controller", func() {
+	var ctx context.Context
+	var cancel context.CancelFunc
+	var clock *cron.FakeClock
+	var counter int64
+	var action run.Runnable
+	var options cron.Options
+	var result chan error
+
+	BeforeEach(func() {
+		ctx, cancel = context.WithCancel(context.Background())
+		clock = cron.NewFakeClock(time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC))
+		atomic.StoreInt64(&counter, 0)
+		action = run.Func(func(ctx context.Context) error {
+			atomic.AddInt64(&counter, 1)
+			return nil
+		})
+		options = cron.Options{
+			Name:  "controller-job",
+			Clock: clock,
+		}
+		result = make(chan error, 1)
+	})
+	AfterEach(func() {
+		cancel()
+	})
+
+	// start runs the cron in the background and returns its Controller.
+	start := func(b run.Runnable) cron.Controller {
+		go func(ctx context.Context, result chan<- error) {
+			result <- b.Run(ctx)
+		}(ctx, result)
+		controller, ok := b.(cron.Controller)
+		Expect(ok).To(BeTrue())
+		return controller
+	}
+
+	Context("interval cron", func() {
+		var controller cron.Controller
+		JustBeforeEach(func() {
+			controller = start(cron.NewIntervalCronWithOptions(libtime.Minute, action, options))
+			Expect(clock.WaitForTimers(ctx, 1)).To(Succeed())
+		})
+		It("skips executions while paused", func() {
+			controller.Pause()
+			Expect(controller.State().Paused).To(BeTrue())
+			clock.Add(time.Minute)
+			Expect(clock.WaitForTimers(ctx, 1)).To(Succeed())
+			Expect(atomic.LoadInt64(&counter)).To(Equal(int64(1)))
+		})
+		It("continues executions after resume", func() {
+			controller.Pause()
+			controller.Resume()
+			Expect(controller.State().Paused).To(BeFalse())
+			clock.Add(time.Minute)
+			Expect(clock.WaitForTimers(ctx, 1)).To(Succeed())
+			Expect(atomic.LoadInt64(&counter)).To(Equal(int64(2)))
+		})
+		It("triggers an execution even if paused", func() {
+			controller.Pause()
+			Expect(controller.TriggerNow(ctx)).To(Succeed())
+			Expect(atomic.LoadInt64(&counter)).To(Equal(int64(2)))
+		})
+		Context("failing action", func() {
+			var actionErr error
+			BeforeEach(func() {
+				actionErr = errors.New("banana")
+				var calls int64
+				action = run.Func(func(ctx context.Context) error {
+					if atomic.AddInt64(&calls, 1) > 1 {
+						return actionErr
+					}
+					return nil
+				})
+			})
+			It("returns the error of a triggered execution", func() {
+				Expect(errors.Is(controller.TriggerNow(ctx), actionErr)).To(BeTrue())
+				Consistently(result).ShouldNot(Receive())
+			})
+		})
+	})
+
+	Context("expression cron", func() {
+		var controller cron.Controller
+		JustBeforeEach(func() {
+			controller = start(cron.NewExpressionCronWithOptions("0 * * * * ?", action, options))
+			Expect(clock.WaitForTimers(ctx, 1)).To(Succeed())
+		})
+		It("skips executions while paused", func() {
+			controller.Pause()
+			clock.Add(time.Minute)
+			Expect(clock.WaitForTimers(ctx, 1)).To(Succeed())
+			Consistently(func() int64 { return atomic.LoadInt64(&counter) }).Should(Equal(int64(0)))
+		})
+		It("triggers an execution", func() {
+			Expect(controller.TriggerNow(ctx)).To(Succeed())
+			Expect(atomic.LoadInt64(&counter)).To(Equal(int64(1)))
+		})
+	})
+
+	Context("state", func() {
+		It("reports a running execution", func() {
+			started := make(chan struct{})
+			release := make(chan struct{})
+			b := cron.NewExpressionCron("0 * * * * ?", run.Func(func(ctx context.Context) error {
+				close(started)
+				<-release
+				return nil
+			}))
+			controller := b.(cron.Controller)
+			Expect(controller.State().Running).To(BeFalse())
+			done := make(chan error, 1)
+			go func() {
+				done <- controller.TriggerNow(ctx)
+			}()
+			Eventually(started).Should(BeClosed())
+			Expect(controller.State().Running).To(BeTrue())
+			close(release)
+			Eventually(done).Should(Receive(BeNil()))
+			Expect(controller.State().Running).To(BeFalse())
+		})
+		It("reports the next run as metric", func() {
+			metrics := &mocks.CronMetrics{}
+			options.EnableMetrics = true
+			options.Metrics = metrics
+			start(cron.NewExpressionCronWithOptions("0 * * * * ?", action, options))
+			Eventually(metrics.SetNextRunCallCount).Should(BeNumerically(">=", 1))
+			name, nextRun := metrics.SetNextRunArgsForCall(0)
+			Expect(name).To(Equal("controller-job"))
+			Expect(nextRun).To(BeTemporally("==", time.Date(2026, 1, 1, 12, 1, 0, 0, time.UTC)))
+		})
+	})
+
+	Context("parallel skip", func() {
+		BeforeEach(func() {
+			options.ParallelSkip = true
+		})
+		It("skips a triggered execution while one is running", func() {
+			started := make(chan struct{})
+			release := make(chan struct{})
+			var calls int64
+			b := cron.NewExpressionCronWithOptions("0 * * * * ?", run.Func(func(ctx context.Context) error {
+				if atomic.AddInt64(&calls, 1) == 1 {
+					close(started)
+					<-release
+				}
+				return nil
+			}), options)
+			controller := b.(cron.Controller)
+			go func() {
+				_ = controller.TriggerNow(ctx)
+			}()
+			Eventually(started).Should(BeClosed())
+			Expect(controller.TriggerNow(ctx)).To(Succeed())
+			Expect(atomic.LoadInt64(&calls)).To(Equal(int64(1)))
+			close(release)
+		})
+	})
+})
+
+var _ = Describe("Scheduler Controller", func() {
+	var ctx context.Context
+	var scheduler cron.Scheduler
+	action := run.Func(func(ctx context.Context) error {
+		return nil
+	})
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		scheduler = cron.NewScheduler()
+	})
+	It("returns the controller of a recurring job", func() {
+		Expect(scheduler.Add(ctx, cron.Job{Name: "job", Wait: libtime.Minute, Action: action})).To(Succeed())
+		controller, err := scheduler.Controller(ctx, "job")
+		Expect(err).To(BeNil())
+		controller.Pause()
+
+		again, err := scheduler.Controller(ctx, "job")
+		Expect(err).To(BeNil())
+		Expect(again.State().Paused).To(BeTrue())
+	})
+	It("fails for one-time jobs", func() {
+		Expect(scheduler.Add(ctx, cron.Job{Name: "once", OneTime: true, Action: action})).To(Succeed())
+		_, err := scheduler.Controller(ctx, "once")
+		Expect(err).NotTo(BeNil())
+	})
+	It("fails for unknown jobs", func() {
+		_, err := scheduler.Controller(ctx, "unknown")
+		Expect(err).NotTo(BeNil())
+	})
+})
@@ -0,0 +1,78 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package cron
+
+import (
+	"context"
+	"sync"
+
+	"github.com/bborbe/errors"
+	"github.com/bborbe/run"
+)
+
+// ErrorPolicy decides how recurring crons react to a failed execution.
+type ErrorPolicy string
+
+const (
+	// ErrorPolicyStop stops the cron on the first failed execution. This is the default.
+	ErrorPolicyStop ErrorPolicy = "stop"
+	// ErrorPolicyContinue logs a failed execution and keeps the schedule running.
+	ErrorPolicyContinue ErrorPolicy = "continue"
+	// ErrorPolicyStopAfterConsecutiveFailures keeps the schedule running until
+	// Options.MaxConsecutiveFailures executions failed in a row. Set MaxConsecutiveFailures,
+	// with its zero value the cron stops on the first failure.
+	ErrorPolicyStopAfterConsecutiveFailures ErrorPolicy = "stop-after-consecutive-failures"
+)
+
+// String returns the error policy as a string.
+func (e ErrorPolicy) String() string {
+	return string(e)
+}
+
+// wrapWithErrorPolicy swallows failed executions as allowed by options.ErrorPolicy.
+// It is applied by the recurring schedulers only, a one-time cron always returns its error.
+func wrapWithErrorPolicy(options Options, fn run.Runnable) run.Runnable {
+	switch options.ErrorPolicy {
+	case ErrorPolicyContinue:
+		return run.Func(func(ctx context.Context) error {
+			if err := fn.Run(ctx); err != nil {
+				jobLogger(ctx, options.Name).WarnContext(ctx, "cron failed, continue with next execution", LogKeyError, err)
+			}
+			return nil
+		})
+	case ErrorPolicyStopAfterConsecutiveFailures:
+		var mux sync.Mutex
+		var consecutiveFailures int
+		return run.Func(func(ctx context.Context) error {
+			err := fn.Run(ctx)
+			mux.Lock()
+			defer mux.Unlock()
+			if err == nil {
+				consecutiveFailures = 0
+				return nil
+			}
+			consecutiveFailures++
+			if consecutiveFailures >= options.MaxConsecutiveFailures {
+				return errors.Wrapf(
+					ctx,
+					err,
+					"cron '%s' failed %d times in a row",
+					options.Name,
+					consecutiveFailures,
+				)
+			}
+			jobLogger(ctx, options.Name).WarnContext(
+				ctx,
+				"cron failed, continue with next execution",
+				"consecutive_failures", consecutiveFailures,
+				"max_consecutive_failures", options.MaxConsecutiveFailures,
+				LogKeyError, err,
+			)
+			return nil
+		})
+	default:
+		return fn
+	}
+}
@@ -0,0 +1,141 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package cron_test
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"time"
+
+	"github.com/bborbe/run"
+	libtime "github.com/bborbe/time"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/bborbe/cron"
+)
+
+var _ = Describe("ErrorPolicy", func() {
+	var ctx context.Context
+	var cancel context.CancelFunc
+	var clock *cron.FakeClock
+	var calls int64
+	var failing atomic.Bool
+	var actionErr error
+	var options cron.Options
+	var result chan error
+
+	// start runs an interval cron with the current options in the background.
+	start := func() {
+		b := cron.NewIntervalCronWithOptions(
+			libtime.Minute,
+			run.Func(func(ctx context.Context) error {
+				atomic.AddInt64(&calls, 1)
+				if failing.Load() {
+					return actionErr
+				}
+				return nil
+			}),
+			options,
+		)
+		go func(ctx context.Context, result chan<- error) {
+			result <- b.Run(ctx)
+		}(ctx, result)
+	}
+
+	// tick waits until the cron waits for the next execution and advances the clock to it.
+	// fail decides whether the triggered execution fails.
+	tick := func(fail bool) {
+		Expect(clock.WaitForTimers(ctx, 1)).To(Succeed())
+		failing.Store(fail)
+		clock.Add(time.Minute)
+	}
+
+	BeforeEach(func() {
+		ctx, cancel = context.WithCancel(context.Background())
+		clock = cron.NewFakeClock(time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC))
+		atomic.StoreInt64(&calls, 0)
+		failing.Store(true)
+		actionErr = errors.New("banana")
+		result = make(chan error, 1)
+		options = cron.Options{
+			Name:  "error-policy-job",
+			Clock: clock,
+		}
+	})
+	AfterEach(func() {
+		cancel()
+	})
+	Context("default", func() {
+		It("stops on the first failure", func() {
+			start()
+			var err error
+			Eventually(result).Should(Receive(&err))
+			Expect(errors.Is(err, actionErr)).To(BeTrue())
+			Expect(atomic.LoadInt64(&calls)).To(Equal(int64(1)))
+		})
+	})
+	Context("stop", func() {
+		BeforeEach(func() {
+			options.ErrorPolicy = cron.ErrorPolicyStop
+		})
+		It("stops on the first failure", func() {
+			start()
+			Eventually(result).Should(Receive(HaveOccurred()))
+			Expect(atomic.LoadInt64(&calls)).To(Equal(int64(1)))
+		})
+	})
+	Context("continue", func() {
+		BeforeEach(func() {
+			options.ErrorPolicy = cron.ErrorPolicyContinue
+		})
+		It("keeps running after failures", func() {
+			start()
+			tick(true)
+			tick(true)
+			Expect(clock.WaitForTimers(ctx, 1)).To(Succeed())
+			Expect(atomic.LoadInt64(&calls)).To(Equal(int64(3)))
+			Consistently(result).ShouldNot(Receive())
+		})
+	})
+	Context("stop after consecutive failures", func() {
+		BeforeEach(func() {
+			options.ErrorPolicy = cron.ErrorPolicyStopAfterConsecutiveFailures
+			options.MaxConsecutiveFailures = 3
+		})
+		It("stops after max consecutive failures", func() {
+			start()
+			tick(true)
+			tick(true)
+			var err error
+			Eventually(result).Should(Receive(&err))
+			Expect(errors.Is(err, actionErr)).To(BeTrue())
+			Expect(err.Error()).To(ContainSubstring("3 times in a row"))
+			Expect(atomic.LoadInt64(&calls)).To(Equal(int64(3)))
+		})
+		It("resets the count after a success", func() {
+			start()
+			tick(false)
+			tick(true)
+			tick(true)
+			Expect(clock.WaitForTimers(ctx, 1)).To(Succeed())
+			Expect(atomic.LoadInt64(&calls)).To(Equal(int64(4)))
+			Consistently(result).ShouldNot(Receive())
+		})
+		Context("without max consecutive failures", func() {
+			BeforeEach(func() {
+				options.MaxConsecutiveFailures = 0
+			})
+			It("stops on the first failure", func() {
+				start()
+				var err error
+				Eventually(result).Should(Receive(&err))
+				Expect(errors.Is(err, actionErr)).To(BeTrue())
+				Expect(atomic.LoadInt64(&calls)).To(Equal(int64(1)))
+			})
+		})
+	})
+})
@@ -0,0 +1,96 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package cron
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"sync/atomic"
+	"time"
+)
+
+const executionInfoContextKey contextKey = "executionInfo"
+
+// ExecutionInfo describes the execution an action is serving.
+// Interval, expression and one-time crons add it to the context passed to the action.
+type ExecutionInfo struct {
+	// Name is the name of the cron from Options.Name. Empty for crons created without options.
+	Name string
+	// Schedule is the cron expression or the interval of the cron. Empty for one-time crons.
+	Schedule string
+	// RunID identifies the execution. It stays the same across retries.
+	RunID string
+	// ScheduledTime is the time the execution was scheduled for.
+	// Zero for manual triggers and one-time crons.
+	ScheduledTime time.Time
+	// Start is the time the execution actually started, after jitter was applied.
+	Start time.Time
+	// Attempt is the current attempt, starting with 1 and increased by WrapWithRetry.
+	Attempt int
+}
+
+// ExecutionFromContext returns the execution info added by the cron running the action.
+// The second result is false if the context carries none.
+func ExecutionFromContext(ctx context.Context) (ExecutionInfo, bool) {
+	state, ok := ctx.Value(executionInfoContextKey).(*executionState)
+	if !ok {
+		return ExecutionInfo{}, false
+	}
+	executionInfo := state.info
+	executionInfo.Attempt = int(state.attempt.Load())
+	return executionInfo, true
+}
+
+// executionState is shared by all wrappers of an execution, so wrappers around
+// WrapWithRetry see the attempt it is currently running.
+type executionState struct {
+	info    ExecutionInfo
+	attempt atomic.Int64
+}
+
+// withExecutionInfo returns a context carrying the info of a new execution started now.
+// The logger of the returned context carries the run ID and scheduled time.
+func withExecutionInfo(ctx context.Context, name string, schedule string, start time.Time) context.Context {
+	executionInfo := ExecutionInfo{
+		Name:          name,
+		Schedule:      schedule,
+		RunID:         newRunID(),
+		ScheduledTime: scheduledTimeFromContext(ctx),
+		Start:         start,
+		Attempt:       1,
+	}
+	loggerArgs := []any{LogKeyRunID, executionInfo.RunID}
+	if !executionInfo.ScheduledTime.IsZero() {
+		loggerArgs = append(loggerArgs, LogKeyScheduledTime, executionInfo.ScheduledTime)
+	}
+	ctx = withLoggerAttrs(ctx, name, loggerArgs...)
+	state := &executionState{info: executionInfo}
+	state.attempt.Store(1)
+	return context.WithValue(ctx, executionInfoContextKey, state)
+}
+
+// withExecution returns the context unchanged if it carries an execution info,
+// e.g. if the wrapper runs in a cron, and adds one for an execution starting now otherwise.
+func withExecution(ctx context.Context, name string, clock Clock) context.Context {
+	if _, ok := ExecutionFromContext(ctx); ok {
+		return ctx
+	}
+	return withExecutionInfo(ctx, name, "", clock.Now())
+}
+
+// setAttempt sets the attempt reported by the execution info of the context, if any.
+func setAttempt(ctx context.Context, attempt int) {
+	if state, ok := ctx.Value(executionInfoContextKey).(*executionState); ok {
+		state.attempt.Store(int64(attempt))
+	}
+}
+
+// newRunID returns a random 128 bit hex string.
+func newRunID() string {
+	b := make([]byte, 16)
+	_, _ = rand.Read(b)
+	return hex.EncodeToString(b)
+}
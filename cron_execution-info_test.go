@@ -0,0 +1,147 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package cron_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/bborbe/run"
+	libtime "github.com/bborbe/time"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/bborbe/cron"
+)
+
+var _ = Describe("ExecutionFromContext", func() {
+	var ctx context.Context
+	var cancel context.CancelFunc
+	var clock *cron.FakeClock
+	var options cron.Options
+	var mux sync.Mutex
+	var executionInfos []cron.ExecutionInfo
+	var action run.Runnable
+
+	// recorded returns the execution infos seen by the action so far.
+	recorded := func() []cron.ExecutionInfo {
+		mux.Lock()
+		defer mux.Unlock()
+		return append([]cron.ExecutionInfo{}, executionInfos...)
+	}
+
+	BeforeEach(func() {
+		ctx, cancel = context.WithCancel(context.Background())
+		clock = cron.NewFakeClock(time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC))
+		options = cron.Options{
+			Name:  "execution-info-job",
+			Clock: clock,
+		}
+		mux.Lock()
+		executionInfos = nil
+		mux.Unlock()
+		action = run.Func(func(ctx context.Context) error {
+			executionInfo, ok := cron.ExecutionFromContext(ctx)
+			Expect(ok).To(BeTrue())
+			mux.Lock()
+			defer mux.Unlock()
+			executionInfos = append(executionInfos, executionInfo)
+			return nil
+		})
+	})
+	AfterEach(func() {
+		cancel()
+	})
+
+	It("returns false without execution", func() {
+		_, ok := cron.ExecutionFromContext(ctx)
+		Expect(ok).To(BeFalse())
+	})
+
+	It("is populated by interval crons", func() {
+		b := cron.NewIntervalCronWithOptions(libtime.Hour, action, options)
+		go func() {
+			_ = b.Run(ctx)
+		}()
+		Expect(clock.WaitForTimers(ctx, 1)).To(Succeed())
+		clock.Add(time.Hour)
+		Eventually(recorded).Should(HaveLen(2))
+		executionInfo := recorded()[1]
+		Expect(executionInfo.Name).To(Equal("execution-info-job"))
+		Expect(executionInfo.Schedule).To(Equal("1h0m0s"))
+		Expect(executionInfo.ScheduledTime).To(BeTemporally("==", time.Date(2026, 1, 1, 13, 0, 0, 0, time.UTC)))
+		Expect(executionInfo.Start).To(BeTemporally("==", time.Date(2026, 1, 1, 13, 0, 0, 0, time.UTC)))
+		Expect(executionInfo.Attempt).To(Equal(1))
+		Expect(executionInfo.RunID).NotTo(BeEmpty())
+		Expect(executionInfo.RunID).NotTo(Equal(recorded()[0].RunID))
+	})
+
+	It("is populated by expression crons", func() {
+		b := cron.NewExpressionCronWithOptions("0 0 * * * ?", action, options)
+		go func() {
+			_ = b.Run(ctx)
+		}()
+		Expect(clock.WaitForTimers(ctx, 1)).To(Succeed())
+		clock.Add(time.Hour + 5*time.Second)
+		Eventually(recorded).Should(HaveLen(1))
+		executionInfo := recorded()[0]
+		Expect(executionInfo.Name).To(Equal("execution-info-job"))
+		Expect(executionInfo.Schedule).To(Equal("0 0 * * * ?"))
+		Expect(executionInfo.ScheduledTime).To(BeTemporally("==", time.Date(2026, 1, 1, 13, 0, 0, 0, time.UTC)))
+		Expect(executionInfo.Start).To(BeTemporally("==", time.Date(2026, 1, 1, 13, 0, 5, 0, time.UTC)))
+	})
+
+	It("is populated by one-time crons", func() {
+		Expect(cron.NewOneTimeCronWithOptions(action, options).Run(ctx)).To(Succeed())
+		Expect(recorded()).To(HaveLen(1))
+		executionInfo := recorded()[0]
+		Expect(executionInfo.Name).To(Equal("execution-info-job"))
+		Expect(executionInfo.ScheduledTime).To(BeZero())
+		Expect(executionInfo.Start).To(BeTemporally("==", clock.Now()))
+		Expect(executionInfo.Attempt).To(Equal(1))
+	})
+
+	It("has no scheduled time for manual triggers", func() {
+		b := cron.NewExpressionCronWithOptions("0 0 * * * ?", action, options)
+		controller, ok := b.(cron.Controller)
+		Expect(ok).To(BeTrue())
+		Expect(controller.TriggerNow(ctx)).To(Succeed())
+		Expect(recorded()).To(HaveLen(1))
+		Expect(recorded()[0].ScheduledTime).To(BeZero())
+	})
+
+	It("reports the attempt and keeps the run ID on retries", func() {
+		options.Retry = cron.RetryOptions{
+			MaxAttempts:    3,
+			InitialBackoff: libtime.Second,
+			Clock:          clock,
+		}
+		inner := action
+		action = run.Func(func(ctx context.Context) error {
+			_ = inner.Run(ctx)
+			if len(recorded()) < 3 {
+				return errors.New("banana")
+			}
+			return nil
+		})
+		result := make(chan error, 1)
+		go func() {
+			result <- cron.NewOneTimeCronWithOptions(action, options).Run(ctx)
+		}()
+		for i := 0; i < 2; i++ {
+			Expect(clock.WaitForTimers(ctx, 1)).To(Succeed())
+			clock.Add(time.Minute)
+		}
+		Eventually(result).Should(Receive(BeNil()))
+		executionInfos := recorded()
+		Expect(executionInfos).To(HaveLen(3))
+		for i, executionInfo := range executionInfos {
+			Expect(executionInfo.Attempt).To(Equal(i + 1))
+			Expect(executionInfo.RunID).To(Equal(executionInfos[0].RunID))
+		}
+	})
+})
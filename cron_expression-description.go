@@ -0,0 +1,249 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package cron
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/bborbe/errors"
+)
+
+var descriptorDescriptions = map[string]string{
+	"@yearly":   "at midnight on January 1",
+	"@annually": "at midnight on January 1",
+	"@monthly":  "at midnight on day 1 of every month",
+	"@weekly":   "at midnight every Sunday",
+	"@daily":    "at midnight every day",
+	"@midnight": "at midnight every day",
+	"@hourly":   "at the start of every hour",
+}
+
+type describeUnit struct {
+	singular string
+	plural   string
+	// names maps values to display names, nil for numeric fields
+	names []string
+}
+
+var (
+	describeSecond = describeUnit{singular: "second", plural: "seconds"}
+	describeMinute = describeUnit{singular: "minute", plural: "minutes"}
+	describeHour   = describeUnit{singular: "hour", plural: "hours"}
+	describeDom    = describeUnit{singular: "day", plural: "days"}
+	describeMonth  = describeUnit{
+		singular: "month",
+		plural:   "months",
+		names: []string{
+			"", "January", "February", "March", "April", "May", "June",
+			"July", "August", "September", "October", "November", "December",
+		},
+	}
+	describeDow = describeUnit{
+		singular: "day of the week",
+		plural:   "days of the week",
+		names: []string{
+			"Sunday", "Monday", "Tuesday", "Wednesday", "Thursday", "Friday", "Saturday",
+		},
+	}
+)
+
+// Describe returns an English description of the expression,
+// e.g. "at second 0 of every 15th minute" for "0 */15 * * * ?".
+// It returns the Validate error if the expression is invalid.
+func (e Expression) Describe(ctx context.Context) (string, error) {
+	if err := e.Validate(ctx); err != nil {
+		return "", errors.Wrap(ctx, err, "validate cron expression failed")
+	}
+	spec, offset, _ := e.splitTimeZone()
+	description := describeSpec(spec)
+	if offset > 0 {
+		zone := strings.TrimSpace(e.String()[strings.Index(e.String(), "=")+1 : offset])
+		description = fmt.Sprintf("%s (%s)", description, zone)
+	}
+	return description, nil
+}
+
+func describeSpec(spec string) string {
+	if strings.HasPrefix(spec, "@every") {
+		return "every " + strings.TrimSpace(strings.TrimPrefix(spec, "@every"))
+	}
+	if strings.HasPrefix(spec, "@") {
+		return descriptorDescriptions[spec]
+	}
+	fields := strings.Fields(spec)
+	description := describeTime(fields[0], fields[1], fields[2])
+	if date := describeDate(fields[3], fields[4], fields[5]); date != "" {
+		description += " " + date
+	} else if isSpecific(fields[2]) {
+		description += " every day"
+	}
+	return description
+}
+
+// describeTime describes second, minute and hour, from the finest to the coarsest field.
+func describeTime(second, minute, hour string) string {
+	if isSingle(second) && isSingle(minute) && isSingle(hour) {
+		return fmt.Sprintf(
+			"at %02d:%02d:%02d",
+			parseValue(hour, describeHour),
+			parseValue(minute, describeMinute),
+			parseValue(second, describeSecond),
+		)
+	}
+	type part struct {
+		value string
+		unit  describeUnit
+	}
+	parts := []part{
+		{value: second, unit: describeSecond},
+		{value: minute, unit: describeMinute},
+		{value: hour, unit: describeHour},
+	}
+	var phrases []string
+	for i, p := range parts {
+		if isStar(p.value) && i > 0 && !isSpecific(parts[i-1].value) {
+			// every finer value is selected, so every coarser value is implied
+			continue
+		}
+		phrases = append(phrases, describeField(p.value, p.unit))
+	}
+	description := strings.Join(phrases, " of ")
+	if !strings.HasPrefix(description, "every") {
+		description = "at " + description
+	}
+	return description
+}
+
+// describeDate describes day-of-month, month and day-of-week. It returns an empty string if all are '*'.
+func describeDate(dom, month, dow string) string {
+	var days []string
+	if !isStar(dom) {
+		days = append(days, "on "+describeField(dom, describeDom)+" of the month")
+	}
+	if !isStar(dow) {
+		days = append(days, "on "+describeField(dow, describeDow))
+	}
+	description := strings.Join(days, " or ")
+	if !isStar(month) {
+		if description != "" {
+			description += " "
+		}
+		description += "in " + describeField(month, describeMonth)
+	}
+	return description
+}
+
+// describeField describes a comma separated field, e.g. "minutes 5 and 10" or "every 15th minute".
+func describeField(value string, unit describeUnit) string {
+	if isStar(value) {
+		return "every " + unit.singular
+	}
+	var values []string
+	var steps []string
+	plural := false
+	for _, item := range strings.Split(value, ",") {
+		base, step, hasStep := strings.Cut(item, "/")
+		if hasStep {
+			steps = append(steps, describeStep(base, step, unit))
+			continue
+		}
+		values = append(values, describeRange(base, unit))
+		plural = plural || strings.Contains(base, "-")
+	}
+	var phrases []string
+	if len(values) > 0 {
+		phrase := joinList(values)
+		switch {
+		case unit.names != nil:
+		case plural || len(values) > 1:
+			phrase = unit.plural + " " + phrase
+		default:
+			phrase = unit.singular + " " + phrase
+		}
+		phrases = append(phrases, phrase)
+	}
+	return joinList(append(phrases, steps...))
+}
+
+func describeStep(base string, step string, unit describeUnit) string {
+	n, _ := strconv.Atoi(step)
+	description := "every " + unit.singular
+	if n > 1 {
+		description = fmt.Sprintf("every %s %s", ordinal(n), unit.singular)
+	}
+	switch {
+	case isStar(base):
+		return description
+	case strings.Contains(base, "-"):
+		return description + " from " + describeRange(base, unit)
+	default:
+		return description + " starting at " + describeValue(base, unit)
+	}
+}
+
+func describeRange(value string, unit describeUnit) string {
+	from, to, isRange := strings.Cut(value, "-")
+	if !isRange {
+		return describeValue(value, unit)
+	}
+	return describeValue(from, unit) + " through " + describeValue(to, unit)
+}
+
+func describeValue(value string, unit describeUnit) string {
+	if unit.names == nil {
+		return value
+	}
+	return unit.names[parseValue(value, unit)]
+}
+
+// parseValue returns the numeric value of a number or a three letter name like JAN or MON.
+func parseValue(value string, unit describeUnit) int {
+	if n, err := strconv.Atoi(value); err == nil {
+		return n
+	}
+	for i, name := range unit.names {
+		if len(name) >= 3 && strings.EqualFold(name[:3], value) {
+			return i
+		}
+	}
+	return 0
+}
+
+func ordinal(n int) string {
+	suffix := "th"
+	switch {
+	case n%100 >= 11 && n%100 <= 13:
+	case n%10 == 1:
+		suffix = "st"
+	case n%10 == 2:
+		suffix = "nd"
+	case n%10 == 3:
+		suffix = "rd"
+	}
+	return strconv.Itoa(n) + suffix
+}
+
+func joinList(values []string) string {
+	if len(values) <= 1 {
+		return strings.Join(values, "")
+	}
+	return strings.Join(values[:len(values)-1], ", ") + " and " + values[len(values)-1]
+}
+
+func isStar(value string) bool {
+	return value == "*" || value == "?" || value == "*/1"
+}
+
+func isSingle(value string) bool {
+	return !strings.ContainsAny(value, "*?,-/")
+}
+
+// isSpecific reports whether the field selects values instead of stepping over its whole range.
+func isSpecific(value string) bool {
+	return !isStar(value) && !strings.HasPrefix(value, "*/") && !strings.HasPrefix(value, "?/")
+}
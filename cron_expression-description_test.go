@@ -0,0 +1,114 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package cron_test
+
+import (
+	"context"
+	"errors"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/bborbe/cron"
+)
+
+var _ = Describe("Expression description", func() {
+	var ctx context.Context
+	BeforeEach(func() {
+		ctx = context.Background()
+	})
+	DescribeTable("Describe",
+		func(expression cron.Expression, expected string) {
+			description, err := expression.Describe(ctx)
+			Expect(err).To(BeNil())
+			Expect(description).To(Equal(expected))
+		},
+		Entry("every second", cron.Expression("* * * * * ?"), "every second"),
+		Entry("every 10th second", cron.Expression("*/10 * * * * ?"), "every 10th second"),
+		Entry("every minute", cron.Expression("0 * * * * ?"), "at second 0 of every minute"),
+		Entry(
+			"every 15 minutes",
+			cron.Expression("0 */15 * * * ?"),
+			"at second 0 of every 15th minute",
+		),
+		Entry(
+			"every hour",
+			cron.Expression("0 0 * * * ?"),
+			"at second 0 of minute 0 of every hour",
+		),
+		Entry("daily", cron.Expression("0 30 2 * * ?"), "at 02:30:00 every day"),
+		Entry(
+			"every hour on sunday",
+			cron.Expression("0 0 * * * 0"),
+			"at second 0 of minute 0 of every hour on Sunday",
+		),
+		Entry(
+			"working hours",
+			cron.Expression("0 0 9-17 * * MON-FRI"),
+			"at second 0 of minute 0 of hours 9 through 17 on Monday through Friday",
+		),
+		Entry(
+			"list",
+			cron.Expression("0,30 0 12 * * ?"),
+			"at seconds 0 and 30 of minute 0 of hour 12 every day",
+		),
+		Entry(
+			"step with range",
+			cron.Expression("0 10-40/10 * * * ?"),
+			"at second 0 of every 10th minute from 10 through 40 of every hour",
+		),
+		Entry(
+			"step with start",
+			cron.Expression("0 5/20 * * * ?"),
+			"at second 0 of every 20th minute starting at 5 of every hour",
+		),
+		Entry(
+			"values and step",
+			cron.Expression("0 1,2,*/30 * * * ?"),
+			"at second 0 of minutes 1 and 2 and every 30th minute of every hour",
+		),
+		Entry(
+			"day of month",
+			cron.Expression("0 0 0 1,15 * ?"),
+			"at 00:00:00 on days 1 and 15 of the month",
+		),
+		Entry(
+			"month names",
+			cron.Expression("0 0 12 * JAN-MAR ?"),
+			"at 12:00:00 in January through March",
+		),
+		Entry(
+			"every 3rd month",
+			cron.Expression("0 0 0 1 */3 ?"),
+			"at 00:00:00 on day 1 of the month in every 3rd month",
+		),
+		Entry(
+			"day of month or day of week",
+			cron.Expression("0 0 0 1 * mon"),
+			"at 00:00:00 on day 1 of the month or on Monday",
+		),
+		Entry(
+			"time zone",
+			cron.Expression("CRON_TZ=Europe/Berlin 0 0 2 * * ?"),
+			"at 02:00:00 every day (Europe/Berlin)",
+		),
+		Entry("every descriptor", cron.Expression("@every 1h"), "every 1h"),
+		Entry("hourly", cron.Expression("@hourly"), "at the start of every hour"),
+		Entry("daily descriptor", cron.Expression("@daily"), "at midnight every day"),
+		Entry("weekly", cron.Expression("@weekly"), "at midnight every Sunday"),
+		Entry("monthly", cron.Expression("@monthly"), "at midnight on day 1 of every month"),
+		Entry("yearly", cron.Expression("@yearly"), "at midnight on January 1"),
+		Entry(
+			"time zone descriptor",
+			cron.Expression("TZ=UTC @every 30m"),
+			"every 30m (UTC)",
+		),
+	)
+	It("returns the validation error", func() {
+		_, err := cron.Expression("0 0 0 32 * ?").Describe(ctx)
+		var validationError *cron.ValidationError
+		Expect(errors.As(err, &validationError)).To(BeTrue())
+	})
+})
@@ -0,0 +1,315 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package cron
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/bborbe/errors"
+	"github.com/robfig/cron/v3"
+)
+
+// Field names used in ValidationError and LintWarning.
+const (
+	FieldExpression = "expression"
+	FieldTimeZone   = "time zone"
+	FieldDescriptor = "descriptor"
+	FieldSecond     = "second"
+	FieldMinute     = "minute"
+	FieldHour       = "hour"
+	FieldDayOfMonth = "day-of-month"
+	FieldMonth      = "month"
+	FieldDayOfWeek  = "day-of-week"
+)
+
+var expressionFields = []string{
+	FieldSecond,
+	FieldMinute,
+	FieldHour,
+	FieldDayOfMonth,
+	FieldMonth,
+	FieldDayOfWeek,
+}
+
+// starBit is set by robfig/cron if a field is '*' or '?'.
+const starBit = 1 << 63
+
+// ValidationError describes why an Expression can not be parsed.
+type ValidationError struct {
+	// Expression is the validated expression.
+	Expression Expression
+	// Field names the offending part, e.g. FieldDayOfMonth.
+	Field string
+	// Position is the zero-based character offset of Value within Expression.
+	Position int
+	// Value is the offending part of the expression.
+	Value string
+	// Reason explains what is wrong with Value.
+	Reason string
+}
+
+func (e *ValidationError) Error() string {
+	return fmt.Sprintf(
+		"invalid %s '%s' at position %d in cron expression '%s': %s",
+		e.Field,
+		e.Value,
+		e.Position,
+		e.Expression,
+		e.Reason,
+	)
+}
+
+// LintWarning describes a valid but suspicious part of an Expression.
+type LintWarning struct {
+	// Field names the suspicious part, e.g. FieldSecond.
+	Field string
+	// Message explains why the part is suspicious.
+	Message string
+}
+
+func (w LintWarning) String() string {
+	return fmt.Sprintf("%s: %s", w.Field, w.Message)
+}
+
+// Validate checks the expression against the default parser.
+// It returns a *ValidationError naming the offending field and position if the expression is invalid.
+func (e Expression) Validate(ctx context.Context) error {
+	value := e.String()
+	if strings.TrimSpace(value) == "" {
+		return e.validationError(FieldExpression, 0, value, "expression is empty")
+	}
+	spec, offset, err := e.splitTimeZone()
+	if err != nil {
+		return err
+	}
+	parser := CreateDefaultParser()
+	if strings.HasPrefix(spec, "@") {
+		if _, err := parser.Parse(spec); err != nil {
+			return e.validationError(FieldDescriptor, offset, spec, err.Error())
+		}
+		return nil
+	}
+	fields, positions := splitFields(spec, offset)
+	if len(fields) != len(expressionFields) {
+		return e.validationError(
+			FieldExpression,
+			offset,
+			spec,
+			fmt.Sprintf("expected %d fields but got %d", len(expressionFields), len(fields)),
+		)
+	}
+	for i, field := range fields {
+		probe := make([]string, len(expressionFields))
+		for j := range probe {
+			probe[j] = "*"
+		}
+		probe[i] = field
+		if _, err := parser.Parse(strings.Join(probe, " ")); err != nil {
+			return e.validationError(expressionFields[i], positions[i], field, err.Error())
+		}
+	}
+	if _, err := parser.Parse(value); err != nil {
+		return e.validationError(FieldExpression, 0, value, err.Error())
+	}
+	return nil
+}
+
+// Lint returns warnings for valid but suspicious expressions, like firing every second
+// or restricting day-of-month to days that never occur.
+// It returns the Validate error if the expression is invalid.
+func (e Expression) Lint(ctx context.Context) ([]LintWarning, error) {
+	if err := e.Validate(ctx); err != nil {
+		return nil, err
+	}
+	schedule, err := CreateDefaultParser().Parse(e.String())
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, "parse cron expression failed")
+	}
+	switch s := schedule.(type) {
+	case cron.ConstantDelaySchedule:
+		return lintConstantDelay(e), nil
+	case *cron.SpecSchedule:
+		return lintSpec(s), nil
+	default:
+		return nil, nil
+	}
+}
+
+func lintConstantDelay(e Expression) []LintWarning {
+	spec, _, _ := e.splitTimeZone()
+	duration, err := time.ParseDuration(strings.TrimSpace(strings.TrimPrefix(spec, "@every")))
+	switch {
+	case err != nil || duration > time.Second:
+		return nil
+	case duration == time.Second:
+		return []LintWarning{
+			{
+				Field:   FieldDescriptor,
+				Message: "fires every second",
+			},
+		}
+	default:
+		return []LintWarning{
+			{
+				Field: FieldDescriptor,
+				Message: fmt.Sprintf(
+					"interval %v is below one second and rounded up to every second",
+					duration,
+				),
+			},
+		}
+	}
+}
+
+func lintSpec(s *cron.SpecSchedule) []LintWarning {
+	var warnings []LintWarning
+	if s.Second&^starBit == bitRange(0, 59) {
+		warnings = append(warnings, LintWarning{
+			Field:   FieldSecond,
+			Message: "fires every second",
+		})
+	}
+	if s.Dom&starBit == 0 && s.Dow&starBit == 0 {
+		warnings = append(warnings, LintWarning{
+			Field:   FieldDayOfWeek,
+			Message: "day-of-month and day-of-week are both restricted, the job fires if either matches",
+		})
+	}
+	if s.Dom&starBit == 0 {
+		warnings = append(warnings, lintDaysOfMonth(s)...)
+	}
+	return warnings
+}
+
+// lintDaysOfMonth reports days that do not occur in some of the selected months.
+func lintDaysOfMonth(s *cron.SpecSchedule) []LintWarning {
+	var warnings []LintWarning
+	reachable := false
+	for day := 1; day <= 31; day++ {
+		if s.Dom&(1<<uint(day)) == 0 {
+			continue
+		}
+		missing, occurs := missingMonths(s, day)
+		reachable = reachable || occurs
+		if len(missing) > 0 {
+			warnings = append(warnings, LintWarning{
+				Field: FieldDayOfMonth,
+				Message: fmt.Sprintf(
+					"day %d does not occur in %s",
+					day,
+					strings.Join(missing, ", "),
+				),
+			})
+		}
+	}
+	if !reachable && s.Dow&starBit != 0 {
+		warnings = append(warnings, LintWarning{
+			Field:   FieldDayOfMonth,
+			Message: "never fires because no selected day occurs in the selected months",
+		})
+	}
+	return warnings
+}
+
+// missingMonths returns the selected months the day does not occur in
+// and whether it occurs in any selected month.
+func missingMonths(s *cron.SpecSchedule, day int) ([]string, bool) {
+	var missing []string
+	occurs := false
+	for month := time.January; month <= time.December; month++ {
+		if s.Month&(1<<uint(month)) == 0 {
+			continue
+		}
+		if day > daysIn(month) {
+			missing = append(missing, month.String())
+			continue
+		}
+		occurs = true
+		if month == time.February && day == 29 {
+			missing = append(missing, "February outside leap years")
+		}
+	}
+	return missing, occurs
+}
+
+// daysIn returns the maximum number of days of the month, including February 29.
+func daysIn(month time.Month) int {
+	return time.Date(2024, month+1, 0, 0, 0, 0, 0, time.UTC).Day()
+}
+
+func bitRange(from, to uint) uint64 {
+	var bits uint64
+	for i := from; i <= to; i++ {
+		bits |= 1 << i
+	}
+	return bits
+}
+
+// splitTimeZone returns the expression without CRON_TZ= or TZ= prefix
+// and the character offset the remaining spec starts at.
+func (e Expression) splitTimeZone() (string, int, error) {
+	value := e.String()
+	if !hasTimeZonePrefix(value) {
+		return value, 0, nil
+	}
+	eq := strings.Index(value, "=")
+	space := strings.Index(value, " ")
+	if space < 0 {
+		return "", 0, e.validationError(
+			FieldTimeZone,
+			eq+1,
+			value[eq+1:],
+			"missing schedule after time zone",
+		)
+	}
+	name := value[eq+1 : space]
+	if _, err := time.LoadLocation(name); err != nil {
+		return "", 0, e.validationError(FieldTimeZone, eq+1, name, err.Error())
+	}
+	offset := space
+	for offset < len(value) && value[offset] == ' ' {
+		offset++
+	}
+	return value[offset:], offset, nil
+}
+
+func (e Expression) validationError(field string, position int, value string, reason string) error {
+	return &ValidationError{
+		Expression: e,
+		Field:      field,
+		Position:   position,
+		Value:      value,
+		Reason:     reason,
+	}
+}
+
+// splitFields splits the spec at whitespace and returns the fields
+// with their character offsets shifted by offset.
+func splitFields(spec string, offset int) ([]string, []int) {
+	var fields []string
+	var positions []int
+	start := -1
+	for i, r := range spec {
+		if r == ' ' || r == '\t' {
+			if start >= 0 {
+				fields = append(fields, spec[start:i])
+				positions = append(positions, offset+start)
+				start = -1
+			}
+			continue
+		}
+		if start < 0 {
+			start = i
+		}
+	}
+	if start >= 0 {
+		fields = append(fields, spec[start:])
+		positions = append(positions, offset+start)
+	}
+	return fields, positions
+}
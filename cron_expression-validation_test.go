@@ -0,0 +1,145 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package cron_test
+
+import (
+	"context"
+	"errors"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/bborbe/cron"
+)
+
+var _ = Describe("Expression validation", func() {
+	var ctx context.Context
+	BeforeEach(func() {
+		ctx = context.Background()
+	})
+	DescribeTable("Validate valid",
+		func(expression cron.Expression) {
+			Expect(expression.Validate(ctx)).To(Succeed())
+		},
+		Entry("every second", cron.Expression("* * * * * ?")),
+		Entry("every 15 minutes", cron.Expression("0 */15 * * * ?")),
+		Entry("names", cron.Expression("0 0 12 * JAN-MAR MON-FRI")),
+		Entry("descriptor", cron.Expression("@every 1h")),
+		Entry("named descriptor", cron.Expression("@daily")),
+		Entry("time zone", cron.Expression("CRON_TZ=Europe/Berlin 0 0 2 * * ?")),
+		Entry("time zone descriptor", cron.Expression("TZ=Europe/Berlin @daily")),
+	)
+	DescribeTable("Validate invalid",
+		func(expression cron.Expression, field string, position int, value string) {
+			err := expression.Validate(ctx)
+			Expect(err).NotTo(BeNil())
+			var validationError *cron.ValidationError
+			Expect(errors.As(err, &validationError)).To(BeTrue())
+			Expect(validationError.Expression).To(Equal(expression))
+			Expect(validationError.Field).To(Equal(field))
+			Expect(validationError.Position).To(Equal(position))
+			Expect(validationError.Value).To(Equal(value))
+			Expect(validationError.Reason).NotTo(BeEmpty())
+			Expect(err.Error()).To(ContainSubstring(field))
+		},
+		Entry("empty", cron.Expression(""), cron.FieldExpression, 0, ""),
+		Entry("too few fields", cron.Expression("0 0 * * *"), cron.FieldExpression, 0, "0 0 * * *"),
+		Entry("second", cron.Expression("60 * * * * ?"), cron.FieldSecond, 0, "60"),
+		Entry("minute", cron.Expression("0 x * * * ?"), cron.FieldMinute, 2, "x"),
+		Entry("hour", cron.Expression("0 0 24 * * ?"), cron.FieldHour, 4, "24"),
+		Entry("day-of-month", cron.Expression("0 0 0 32 * ?"), cron.FieldDayOfMonth, 6, "32"),
+		Entry("month", cron.Expression("0 0 0 1  13 ?"), cron.FieldMonth, 9, "13"),
+		Entry("day-of-week", cron.Expression("0 0 0 * * 8"), cron.FieldDayOfWeek, 10, "8"),
+		Entry("range", cron.Expression("0 0 5-2 * * ?"), cron.FieldHour, 4, "5-2"),
+		Entry("descriptor", cron.Expression("@every banana"), cron.FieldDescriptor, 0, "@every banana"),
+		Entry("unknown descriptor", cron.Expression("@sometimes"), cron.FieldDescriptor, 0, "@sometimes"),
+		Entry(
+			"time zone",
+			cron.Expression("TZ=Mars/Olympus 0 0 2 * * ?"),
+			cron.FieldTimeZone,
+			3,
+			"Mars/Olympus",
+		),
+		Entry(
+			"time zone without schedule",
+			cron.Expression("TZ=Europe/Berlin"),
+			cron.FieldTimeZone,
+			3,
+			"Europe/Berlin",
+		),
+		Entry(
+			"field after time zone",
+			cron.Expression("CRON_TZ=Europe/Berlin 0 61 2 * * ?"),
+			cron.FieldMinute,
+			24,
+			"61",
+		),
+	)
+	It("makes NewExpressionCron fail with ValidationError", func() {
+		err := cron.NewExpressionCron("0 0 0 32 * ?", nil).Run(ctx)
+		var validationError *cron.ValidationError
+		Expect(errors.As(err, &validationError)).To(BeTrue())
+		Expect(validationError.Field).To(Equal(cron.FieldDayOfMonth))
+	})
+	DescribeTable("Lint",
+		func(expression cron.Expression, expected []cron.LintWarning) {
+			warnings, err := expression.Lint(ctx)
+			Expect(err).To(BeNil())
+			Expect(warnings).To(Equal(expected))
+		},
+		Entry("every hour", cron.Expression("0 0 * * * ?"), nil),
+		Entry("descriptor", cron.Expression("@every 1h"), nil),
+		Entry("named descriptor", cron.Expression("@weekly"), nil),
+		Entry("every second", cron.Expression("* * * * * ?"), []cron.LintWarning{
+			{Field: cron.FieldSecond, Message: "fires every second"},
+		}),
+		Entry("every second descriptor", cron.Expression("@every 1s"), []cron.LintWarning{
+			{Field: cron.FieldDescriptor, Message: "fires every second"},
+		}),
+		Entry("below one second", cron.Expression("@every 100ms"), []cron.LintWarning{
+			{
+				Field:   cron.FieldDescriptor,
+				Message: "interval 100ms is below one second and rounded up to every second",
+			},
+		}),
+		Entry("day-of-month and day-of-week", cron.Expression("0 0 0 1 * MON"), []cron.LintWarning{
+			{
+				Field:   cron.FieldDayOfWeek,
+				Message: "day-of-month and day-of-week are both restricted, the job fires if either matches",
+			},
+		}),
+		Entry("day 31", cron.Expression("0 0 0 31 * ?"), []cron.LintWarning{
+			{
+				Field:   cron.FieldDayOfMonth,
+				Message: "day 31 does not occur in February, April, June, September, November",
+			},
+		}),
+		Entry("day 31 in long months", cron.Expression("0 0 0 31 1,3 ?"), nil),
+		Entry("leap day", cron.Expression("0 0 0 29 2 ?"), []cron.LintWarning{
+			{
+				Field:   cron.FieldDayOfMonth,
+				Message: "day 29 does not occur in February outside leap years",
+			},
+		}),
+		Entry("never", cron.Expression("0 0 0 30,31 2 ?"), []cron.LintWarning{
+			{Field: cron.FieldDayOfMonth, Message: "day 30 does not occur in February"},
+			{Field: cron.FieldDayOfMonth, Message: "day 31 does not occur in February"},
+			{
+				Field:   cron.FieldDayOfMonth,
+				Message: "never fires because no selected day occurs in the selected months",
+			},
+		}),
+	)
+	It("returns the validation error on lint", func() {
+		_, err := cron.Expression("0 0 0 32 * ?").Lint(ctx)
+		var validationError *cron.ValidationError
+		Expect(errors.As(err, &validationError)).To(BeTrue())
+	})
+	It("formats lint warnings", func() {
+		Expect(cron.LintWarning{Field: cron.FieldSecond, Message: "fires every second"}.String()).To(
+			Equal("second: fires every second"),
+		)
+	})
+})
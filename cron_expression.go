@@ -7,10 +7,12 @@ package cron
 import (
 	"context"
 	"fmt"
+	"strings"
+	"sync"
+	"time"
 
 	"github.com/bborbe/errors"
 	"github.com/bborbe/run"
-	"github.com/golang/glog"
 	"github.com/robfig/cron/v3"
 )
 
@@ -26,6 +28,8 @@ import (
 // 0 0 * * * ?
 // every hour on sunday:
 // 0 0 * * * 0
+// daily at 02:00 in Berlin, regardless of the process time zone:
+// CRON_TZ=Europe/Berlin 0 0 2 * * ?
 type Expression string
 
 // String returns the cron expression as a string.
@@ -43,70 +47,311 @@ func (e Expression) Bytes() []byte {
 	return []byte(e)
 }
 
+// Schedule parses the expression with the default parser.
+// Expressions without a CRON_TZ= or TZ= prefix are evaluated in the given location;
+// a nil location falls back to the process local time.
+func (e Expression) Schedule(ctx context.Context, location *time.Location) (cron.Schedule, error) {
+	return parseSchedule(ctx, CreateDefaultParser(), e, location)
+}
+
+// NextN returns the next n times the expression fires after the given time.
+// The result is shorter than n if the expression stops firing.
+// It stops with the error of the context if the context is cancelled.
+// Returned times use the location of after.
+func (e Expression) NextN(
+	ctx context.Context,
+	location *time.Location,
+	after time.Time,
+	n int,
+) ([]time.Time, error) {
+	schedule, err := e.Schedule(ctx, location)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, "create schedule failed")
+	}
+	if n < 0 {
+		return nil, errors.Errorf(ctx, "n must not be negative but was %d", n)
+	}
+	// n may be huge, e.g. to read until the expression stops firing, so grow on demand
+	result := make([]time.Time, 0, min(n, 1024))
+	for len(result) < n {
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		default:
+		}
+		after = schedule.Next(after)
+		if after.IsZero() {
+			break
+		}
+		result = append(result, after)
+	}
+	return result, nil
+}
+
+// Between returns the times the expression fires within [from, until), at most limit.
+// Descriptors like '@every 1h' count their interval from the given from.
+// Returned times use the location of from.
+func (e Expression) Between(
+	ctx context.Context,
+	location *time.Location,
+	from time.Time,
+	until time.Time,
+	limit int,
+) ([]time.Time, error) {
+	schedule, err := e.Schedule(ctx, location)
+	if err != nil {
+		return nil, errors.Wrap(ctx, err, "create schedule failed")
+	}
+	if limit < 0 {
+		return nil, errors.Errorf(ctx, "limit must not be negative but was %d", limit)
+	}
+	var result []time.Time
+	next := firstFire(schedule, from)
+	for !next.IsZero() && next.Before(until) && len(result) < limit {
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		default:
+		}
+		result = append(result, next)
+		next = schedule.Next(next)
+	}
+	return result, nil
+}
+
 // NewExpressionCron creates a cron job that executes based on a cron expression.
 // The expression supports standard cron format and common descriptors like '@every 1h'.
+// The returned cron implements Controller.
 func NewExpressionCron(
 	expression Expression,
 	action run.Runnable,
 ) run.Runnable {
+	clock := NewClock()
+	jobControl := newJobControl(Options{}, expression.String(), clock, action)
 	return &cronExpression{
+		jobControl: jobControl,
 		expression: expression,
-		action:     action,
+		action:     jobControl.skipIfPaused(run.Func(jobControl.execute)),
 		parser:     CreateDefaultParser(),
+		clock:      clock,
 	}
 }
 
 // NewExpressionCronWithOptions creates an expression-based cron job with configurable options.
 // Applies timeout, metrics, and parallel execution controls to individual action executions.
+// The returned cron implements Controller.
 func NewExpressionCronWithOptions(
 	expression Expression,
 	action run.Runnable,
 	options Options,
 ) run.Runnable {
-	return NewExpressionCron(expression, WrapWithOptions(action, options))
+	clock := options.clockOrDefault()
+	jobControl := newJobControl(options, expression.String(), clock, WrapWithOptions(action, options))
+	return &cronExpression{
+		jobControl: jobControl,
+		expression: expression,
+		action: wrapWithErrorPolicy(
+			options,
+			jobControl.skipIfPaused(
+				wrapWithJitter(
+					options,
+					wrapWithLastSuccess(
+						options,
+						run.Func(jobControl.execute),
+					),
+				),
+			),
+		),
+		parser:        CreateDefaultParser(),
+		location:      options.Location,
+		clock:         clock,
+		name:          options.Name,
+		stateStore:    options.StateStore,
+		misfirePolicy: options.MisfirePolicy,
+		maxMisfires:   options.MaxMisfires,
+	}
 }
 
 type cronExpression struct {
-	expression Expression
-	action     run.Runnable
-	parser     cron.Parser
+	*jobControl
+	expression    Expression
+	action        run.Runnable
+	parser        cron.Parser
+	location      *time.Location
+	clock         Clock
+	name          string
+	stateStore    StateStore
+	misfirePolicy MisfirePolicy
+	maxMisfires   int
 }
 
 func (c *cronExpression) Run(ctx context.Context) error {
-	glog.V(4).Infof("register cron actions")
-	schedule, err := c.parser.Parse(c.expression.String())
+	ctx = c.withLogger(ctx)
+	logger := LoggerFromContext(ctx)
+	defer c.shutdown(ctx)
+	logger.Log(ctx, LevelTrace, "register cron actions")
+	schedule, err := parseSchedule(ctx, c.parser, c.expression, c.location)
 	if err != nil {
-		return errors.Wrap(
-			ctx,
-			err,
-			fmt.Sprintf("parse cron expression '%s' failed", c.expression),
-		)
+		return errors.Wrap(ctx, err, "create schedule failed")
 	}
 
-	cronJob := cron.New()
-	cronJob.Start()
-	errChan := make(chan error)
-	job := cron.FuncJob(func() {
-		glog.V(4).Infof("run cron action started")
-		if err := c.action.Run(ctx); err != nil {
-			errChan <- err
-		}
-		glog.V(4).Infof("run cron action completed")
-	})
-	id := cronJob.Schedule(schedule, job)
-	glog.V(3).Infof("scheduled job: %v", id)
+	// locks of executions are kept until their ttl passed or the cron stops
+	ticks := newSharedTicks()
+	defer ticks.close()
+	ctx = withSharedTicks(ctx, ticks)
 
+	var wg sync.WaitGroup
+	errChan := make(chan error, 1)
+	c.catchUp(ctx, schedule, &wg, errChan)
+	err = c.schedule(ctx, schedule, &wg, errChan)
+	c.setNextRun(time.Time{})
+
+	logger.InfoContext(ctx, "stopping cron started")
+	stopped := make(chan struct{})
+	go func() {
+		wg.Wait()
+		close(stopped)
+	}()
 	select {
-	case err = <-errChan:
-	case <-ctx.Done():
-		err = nil
-	}
-	glog.V(2).Infof("stopping cron started")
-	stopContext := cronJob.Stop()
-	select {
-	case err = <-errChan:
-	case <-stopContext.Done():
-		glog.V(2).Infof("stopping cron completed")
+	case runErr := <-errChan:
+		err = runErr
+	case <-stopped:
+		select {
+		case runErr := <-errChan:
+			err = runErr
+		default:
+		}
+		logger.InfoContext(ctx, "stopping cron completed")
 	}
 	return err
 }
+
+// catchUp runs the executions missed since the last success according to the misfire policy.
+// They run one after another in the background while the regular schedule continues.
+func (c *cronExpression) catchUp(
+	ctx context.Context,
+	schedule cron.Schedule,
+	wg *sync.WaitGroup,
+	errChan chan error,
+) {
+	if c.stateStore == nil {
+		return
+	}
+	lastSuccess, err := c.stateStore.LastSuccess(ctx, c.name)
+	if err != nil {
+		LoggerFromContext(ctx).WarnContext(ctx, "read last success failed, skip catch-up", LogKeyError, err)
+		return
+	}
+	missed := missedTimes(schedule, c.misfirePolicy, c.maxMisfires, lastSuccess, c.clock.Now())
+	if len(missed) == 0 {
+		return
+	}
+	LoggerFromContext(ctx).InfoContext(
+		ctx,
+		"cron missed executions, catch up",
+		"last_success", lastSuccess,
+		"catch_up", len(missed),
+	)
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for _, scheduledTime := range missed {
+			if ctx.Err() != nil {
+				return
+			}
+			if err := c.action.Run(withCatchUp(withScheduledTime(ctx, scheduledTime))); err != nil {
+				select {
+				case errChan <- err:
+				default:
+				}
+				return
+			}
+		}
+	}()
+}
+
+// schedule starts the action at every time the schedule yields until the context is done
+// or an execution failed.
+func (c *cronExpression) schedule(
+	ctx context.Context,
+	schedule cron.Schedule,
+	wg *sync.WaitGroup,
+	errChan chan error,
+) error {
+	for {
+		now := c.clock.Now()
+		next := schedule.Next(now)
+		if next.IsZero() {
+			LoggerFromContext(ctx).WarnContext(ctx, "cron expression never fires", "expression", c.expression)
+			select {
+			case <-ctx.Done():
+				return nil
+			case err := <-errChan:
+				return err
+			}
+		}
+		LoggerFromContext(ctx).Log(ctx, LevelTrace, "next cron execution", LogKeyScheduledTime, next)
+		c.scheduled(ctx, next)
+		timer := c.clock.NewTimer(next.Sub(now))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil
+		case err := <-errChan:
+			timer.Stop()
+			return err
+		case <-timer.C():
+		}
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if err := c.action.Run(withScheduledTime(ctx, next)); err != nil {
+				select {
+				case errChan <- err:
+				default:
+				}
+			}
+		}()
+	}
+}
+
+func parseSchedule(
+	ctx context.Context,
+	parser cron.Parser,
+	expression Expression,
+	location *time.Location,
+) (cron.Schedule, error) {
+	// validate first, robfig/cron panics on a time zone prefix without schedule
+	if err := expression.Validate(ctx); err != nil {
+		return nil, errors.Wrap(ctx, err, "validate cron expression failed")
+	}
+	value := expression.String()
+	schedule, err := parser.Parse(value)
+	if err != nil {
+		return nil, errors.Wrap(
+			ctx,
+			err,
+			fmt.Sprintf("parse cron expression '%s' failed", expression),
+		)
+	}
+	if location == nil || hasTimeZonePrefix(value) {
+		return schedule, nil
+	}
+	if specSchedule, ok := schedule.(*cron.SpecSchedule); ok {
+		specSchedule.Location = location
+	}
+	return schedule, nil
+}
+
+// firstFire returns the first time the schedule fires at or after from.
+func firstFire(schedule cron.Schedule, from time.Time) time.Time {
+	if _, ok := schedule.(*cron.SpecSchedule); ok {
+		// Next is strictly after, so step back to include from itself
+		return schedule.Next(from.Add(-time.Nanosecond))
+	}
+	return schedule.Next(from)
+}
+
+func hasTimeZonePrefix(value string) bool {
+	return strings.HasPrefix(value, "CRON_TZ=") || strings.HasPrefix(value, "TZ=")
+}
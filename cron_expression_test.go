@@ -7,6 +7,7 @@ package cron_test
 import (
 	"context"
 	"errors"
+	"math"
 	"sync"
 	"sync/atomic"
 	"time"
@@ -14,6 +15,7 @@ import (
 	"github.com/bborbe/run"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	robfigcron "github.com/robfig/cron/v3"
 
 	"github.com/bborbe/cron"
 )
@@ -191,3 +193,454 @@ var _ = Describe("ExpressionCron", func() {
 		})
 	})
 })
+
+var _ = Describe("Expression", func() {
+	var ctx context.Context
+	var berlin *time.Location
+	BeforeEach(func() {
+		ctx = context.Background()
+		var err error
+		berlin, err = time.LoadLocation("Europe/Berlin")
+		Expect(err).To(BeNil())
+	})
+	Context("Schedule", func() {
+		var expression cron.Expression
+		var location *time.Location
+		var schedule robfigcron.Schedule
+		var err error
+		BeforeEach(func() {
+			expression = "0 0 2 * * ?"
+			location = nil
+		})
+		JustBeforeEach(func() {
+			schedule, err = expression.Schedule(ctx, location)
+		})
+		nextN := func(after time.Time, n int) []time.Time {
+			result := make([]time.Time, 0, n)
+			for i := 0; i < n; i++ {
+				after = schedule.Next(after)
+				result = append(result, after.UTC())
+			}
+			return result
+		}
+		Context("without location", func() {
+			It("returns no error", func() {
+				Expect(err).To(BeNil())
+			})
+			It("uses the process local time", func() {
+				Expect(schedule.Next(time.Date(2026, 1, 1, 12, 0, 0, 0, time.Local))).To(
+					BeTemporally("==", time.Date(2026, 1, 2, 2, 0, 0, 0, time.Local)),
+				)
+			})
+		})
+		Context("with location", func() {
+			BeforeEach(func() {
+				location = berlin
+			})
+			It("returns no error", func() {
+				Expect(err).To(BeNil())
+			})
+			It("fires at 02:00 Berlin in winter", func() {
+				Expect(schedule.Next(time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC))).To(
+					Equal(time.Date(2026, 1, 2, 1, 0, 0, 0, time.UTC)),
+				)
+			})
+			It("fires at 02:00 Berlin in summer", func() {
+				Expect(schedule.Next(time.Date(2026, 7, 1, 12, 0, 0, 0, time.UTC))).To(
+					Equal(time.Date(2026, 7, 2, 0, 0, 0, 0, time.UTC)),
+				)
+			})
+			It("skips the non-existing 02:00 on the spring-forward day", func() {
+				Expect(nextN(time.Date(2026, 3, 28, 12, 0, 0, 0, berlin), 2)).To(Equal([]time.Time{
+					time.Date(2026, 3, 30, 0, 0, 0, 0, time.UTC),
+					time.Date(2026, 3, 31, 0, 0, 0, 0, time.UTC),
+				}))
+			})
+			It("fires for both 02:00 on the fall-back day", func() {
+				Expect(nextN(time.Date(2026, 10, 24, 12, 0, 0, 0, berlin), 3)).To(Equal([]time.Time{
+					time.Date(2026, 10, 25, 0, 0, 0, 0, time.UTC),
+					time.Date(2026, 10, 25, 1, 0, 0, 0, time.UTC),
+					time.Date(2026, 10, 26, 1, 0, 0, 0, time.UTC),
+				}))
+			})
+			Context("hour after the gap", func() {
+				BeforeEach(func() {
+					expression = "0 0 3 * * ?"
+				})
+				It("fires once on the spring-forward day", func() {
+					Expect(nextN(time.Date(2026, 3, 28, 12, 0, 0, 0, berlin), 2)).To(Equal([]time.Time{
+						time.Date(2026, 3, 29, 1, 0, 0, 0, time.UTC),
+						time.Date(2026, 3, 30, 1, 0, 0, 0, time.UTC),
+					}))
+				})
+				It("fires once on the fall-back day", func() {
+					Expect(nextN(time.Date(2026, 10, 24, 12, 0, 0, 0, berlin), 2)).To(Equal([]time.Time{
+						time.Date(2026, 10, 25, 2, 0, 0, 0, time.UTC),
+						time.Date(2026, 10, 26, 2, 0, 0, 0, time.UTC),
+					}))
+				})
+			})
+			Context("descriptor", func() {
+				BeforeEach(func() {
+					expression = "@daily"
+				})
+				It("fires at midnight Berlin", func() {
+					Expect(schedule.Next(time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC))).To(
+						Equal(time.Date(2026, 1, 1, 23, 0, 0, 0, time.UTC)),
+					)
+				})
+			})
+		})
+		Context("with time zone prefix", func() {
+			BeforeEach(func() {
+				expression = "CRON_TZ=America/New_York 0 0 2 * * ?"
+				location = berlin
+			})
+			It("returns no error", func() {
+				Expect(err).To(BeNil())
+			})
+			It("prefers the prefix over the location", func() {
+				Expect(schedule.Next(time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC))).To(
+					Equal(time.Date(2026, 1, 2, 7, 0, 0, 0, time.UTC)),
+				)
+			})
+		})
+		Context("with time zone prefix only", func() {
+			BeforeEach(func() {
+				expression = "TZ=Europe/Berlin"
+			})
+			It("returns error", func() {
+				Expect(err).NotTo(BeNil())
+			})
+		})
+		Context("with unknown time zone", func() {
+			BeforeEach(func() {
+				expression = "TZ=Mars/Olympus 0 0 2 * * ?"
+			})
+			It("returns error", func() {
+				Expect(err).NotTo(BeNil())
+			})
+		})
+		Context("invalid expression", func() {
+			BeforeEach(func() {
+				expression = "banana"
+			})
+			It("returns error", func() {
+				Expect(err).NotTo(BeNil())
+			})
+		})
+	})
+})
+
+var _ = Describe("Expression preview", func() {
+	var ctx context.Context
+	var expression cron.Expression
+	var location *time.Location
+	var now time.Time
+	var result []time.Time
+	var err error
+	BeforeEach(func() {
+		ctx = context.Background()
+		expression = "0 */15 * * * ?"
+		location = nil
+		now = time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	})
+	Context("NextN", func() {
+		var n int
+		BeforeEach(func() {
+			n = 3
+		})
+		JustBeforeEach(func() {
+			result, err = expression.NextN(ctx, location, now, n)
+		})
+		It("returns no error", func() {
+			Expect(err).To(BeNil())
+		})
+		It("returns the next times after now", func() {
+			Expect(result).To(Equal([]time.Time{
+				time.Date(2026, 1, 1, 12, 15, 0, 0, time.UTC),
+				time.Date(2026, 1, 1, 12, 30, 0, 0, time.UTC),
+				time.Date(2026, 1, 1, 12, 45, 0, 0, time.UTC),
+			}))
+		})
+		Context("with location", func() {
+			BeforeEach(func() {
+				var loadErr error
+				location, loadErr = time.LoadLocation("Europe/Berlin")
+				Expect(loadErr).To(BeNil())
+				expression = "0 0 2 * * ?"
+				n = 2
+			})
+			It("returns the times in the location", func() {
+				Expect(result).To(Equal([]time.Time{
+					time.Date(2026, 1, 2, 1, 0, 0, 0, time.UTC),
+					time.Date(2026, 1, 3, 1, 0, 0, 0, time.UTC),
+				}))
+			})
+		})
+		Context("descriptor", func() {
+			BeforeEach(func() {
+				expression = "@every 1h"
+				n = 2
+			})
+			It("returns the times", func() {
+				Expect(result).To(Equal([]time.Time{
+					time.Date(2026, 1, 1, 13, 0, 0, 0, time.UTC),
+					time.Date(2026, 1, 1, 14, 0, 0, 0, time.UTC),
+				}))
+			})
+		})
+		Context("zero", func() {
+			BeforeEach(func() {
+				n = 0
+			})
+			It("returns empty", func() {
+				Expect(err).To(BeNil())
+				Expect(result).To(BeEmpty())
+			})
+		})
+		Context("negative", func() {
+			BeforeEach(func() {
+				n = -1
+			})
+			It("returns error", func() {
+				Expect(err).NotTo(BeNil())
+			})
+		})
+		Context("never firing", func() {
+			BeforeEach(func() {
+				expression = "0 0 0 30 2 ?"
+			})
+			It("returns empty", func() {
+				Expect(err).To(BeNil())
+				Expect(result).To(BeEmpty())
+			})
+		})
+		Context("huge n", func() {
+			BeforeEach(func() {
+				n = math.MaxInt
+			})
+			Context("never firing", func() {
+				BeforeEach(func() {
+					expression = "0 0 0 30 2 ?"
+				})
+				It("returns empty", func() {
+					Expect(err).To(BeNil())
+					Expect(result).To(BeEmpty())
+				})
+			})
+			Context("cancelled context", func() {
+				BeforeEach(func() {
+					var cancel context.CancelFunc
+					ctx, cancel = context.WithCancel(ctx)
+					cancel()
+				})
+				It("returns the context error", func() {
+					Expect(err).To(MatchError(context.Canceled))
+				})
+			})
+		})
+		Context("invalid expression", func() {
+			BeforeEach(func() {
+				expression = "banana"
+			})
+			It("returns error", func() {
+				Expect(err).NotTo(BeNil())
+			})
+		})
+	})
+	Context("Between", func() {
+		var until time.Time
+		var limit int
+		BeforeEach(func() {
+			until = now.Add(time.Hour)
+			limit = 100
+		})
+		JustBeforeEach(func() {
+			result, err = expression.Between(ctx, location, now, until, limit)
+		})
+		It("returns no error", func() {
+			Expect(err).To(BeNil())
+		})
+		It("returns all times in the window including the start", func() {
+			Expect(result).To(Equal([]time.Time{
+				time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC),
+				time.Date(2026, 1, 1, 12, 15, 0, 0, time.UTC),
+				time.Date(2026, 1, 1, 12, 30, 0, 0, time.UTC),
+				time.Date(2026, 1, 1, 12, 45, 0, 0, time.UTC),
+			}))
+		})
+		Context("start between seconds", func() {
+			BeforeEach(func() {
+				now = now.Add(time.Millisecond)
+			})
+			It("excludes times before the start", func() {
+				Expect(result).To(HaveLen(3))
+				Expect(result[0]).To(Equal(time.Date(2026, 1, 1, 12, 15, 0, 0, time.UTC)))
+			})
+		})
+		Context("with location", func() {
+			BeforeEach(func() {
+				var loadErr error
+				location, loadErr = time.LoadLocation("Europe/Berlin")
+				Expect(loadErr).To(BeNil())
+				expression = "0 0 2 * * ?"
+				now = time.Date(2026, 10, 24, 0, 0, 0, 0, time.UTC)
+				until = now.Add(72 * time.Hour)
+			})
+			It("returns the times in the location across the fall-back day", func() {
+				Expect(result).To(Equal([]time.Time{
+					time.Date(2026, 10, 24, 0, 0, 0, 0, time.UTC),
+					time.Date(2026, 10, 25, 0, 0, 0, 0, time.UTC),
+					time.Date(2026, 10, 25, 1, 0, 0, 0, time.UTC),
+					time.Date(2026, 10, 26, 1, 0, 0, 0, time.UTC),
+				}))
+			})
+		})
+		Context("descriptor", func() {
+			BeforeEach(func() {
+				expression = "@every 20m"
+			})
+			It("counts from the start", func() {
+				Expect(result).To(Equal([]time.Time{
+					time.Date(2026, 1, 1, 12, 20, 0, 0, time.UTC),
+					time.Date(2026, 1, 1, 12, 40, 0, 0, time.UTC),
+				}))
+			})
+		})
+		Context("empty window", func() {
+			BeforeEach(func() {
+				until = now
+			})
+			It("returns empty", func() {
+				Expect(err).To(BeNil())
+				Expect(result).To(BeEmpty())
+			})
+		})
+		Context("limit", func() {
+			BeforeEach(func() {
+				expression = "* * * * * ?"
+				until = now.AddDate(1, 0, 0)
+				limit = 3
+			})
+			It("returns at most limit times", func() {
+				Expect(err).To(BeNil())
+				Expect(result).To(Equal([]time.Time{
+					time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC),
+					time.Date(2026, 1, 1, 12, 0, 1, 0, time.UTC),
+					time.Date(2026, 1, 1, 12, 0, 2, 0, time.UTC),
+				}))
+			})
+		})
+		Context("negative limit", func() {
+			BeforeEach(func() {
+				limit = -1
+			})
+			It("returns error", func() {
+				Expect(err).NotTo(BeNil())
+			})
+		})
+		Context("cancelled context", func() {
+			BeforeEach(func() {
+				var cancel context.CancelFunc
+				ctx, cancel = context.WithCancel(ctx)
+				cancel()
+			})
+			It("returns error", func() {
+				Expect(err).To(MatchError(context.Canceled))
+			})
+		})
+	})
+})
+
+var _ = Describe("ExpressionCron with FakeClock", func() {
+	var ctx context.Context
+	var cancel context.CancelFunc
+	var clock *cron.FakeClock
+	var expression cron.Expression
+	var options cron.Options
+	var actionErr error
+	var executions chan time.Time
+	var result chan error
+	BeforeEach(func() {
+		ctx, cancel = context.WithCancel(context.Background())
+		clock = cron.NewFakeClock(time.Date(2026, 1, 1, 12, 0, 30, 0, time.UTC))
+		expression = "0 * * * * ?"
+		options = cron.Options{Clock: clock}
+		actionErr = nil
+		executions = make(chan time.Time, 100)
+		result = make(chan error, 1)
+	})
+	JustBeforeEach(func() {
+		clock, executions, actionErr := clock, executions, actionErr
+		b := cron.NewExpressionCronWithOptions(
+			expression,
+			run.Func(func(ctx context.Context) error {
+				executions <- clock.Now()
+				return actionErr
+			}),
+			options,
+		)
+		go func(ctx context.Context, result chan<- error) {
+			result <- b.Run(ctx)
+		}(ctx, result)
+		Expect(clock.WaitForTimers(ctx, 1)).To(Succeed())
+	})
+	AfterEach(func() {
+		cancel()
+	})
+	It("does not run before the first scheduled time", func() {
+		clock.Add(29 * time.Second)
+		Consistently(executions, 50*time.Millisecond).ShouldNot(Receive())
+	})
+	It("runs at each scheduled time", func() {
+		clock.Add(30 * time.Second)
+		Eventually(executions).Should(Receive(Equal(time.Date(2026, 1, 1, 12, 1, 0, 0, time.UTC))))
+		for i := 2; i <= 3; i++ {
+			Expect(clock.WaitForTimers(ctx, 1)).To(Succeed())
+			clock.Add(time.Minute)
+			Eventually(executions).Should(Receive(Equal(time.Date(2026, 1, 1, 12, i, 0, 0, time.UTC))))
+		}
+		Expect(executions).NotTo(Receive())
+	})
+	It("returns no error on cancel", func() {
+		cancel()
+		Eventually(result).Should(Receive(BeNil()))
+		Expect(clock.Timers()).To(Equal(0))
+	})
+	Context("action fails", func() {
+		BeforeEach(func() {
+			actionErr = errors.New("banana")
+		})
+		It("returns the error", func() {
+			clock.Add(30 * time.Second)
+			Eventually(result).Should(Receive(MatchError(actionErr)))
+		})
+	})
+	Context("with location", func() {
+		BeforeEach(func() {
+			berlin, err := time.LoadLocation("Europe/Berlin")
+			Expect(err).To(BeNil())
+			expression = "0 0 2 * * ?"
+			options.Location = berlin
+		})
+		It("runs at the time in the location", func() {
+			clock.Add(13 * time.Hour)
+			Eventually(executions).Should(Receive(Equal(time.Date(2026, 1, 2, 1, 0, 30, 0, time.UTC))))
+		})
+	})
+})
+
+var _ = Describe("ExpressionCron never firing", func() {
+	It("returns no error on cancel", func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		go func() {
+			time.Sleep(10 * time.Millisecond)
+			cancel()
+		}()
+		b := cron.NewExpressionCron("0 0 0 30 2 ?", run.Func(func(ctx context.Context) error {
+			return errors.New("should not run")
+		}))
+		Expect(b.Run(ctx)).To(BeNil())
+	})
+})
@@ -0,0 +1,94 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package cron
+
+import (
+	"encoding/json"
+	"sync"
+	"time"
+)
+
+// DefaultHistorySize is the number of executions a Scheduler keeps per job
+// if Options.History is not set.
+const DefaultHistorySize = 20
+
+// Execution describes a single execution of a cron.
+type Execution struct {
+	// ScheduledTime is the time the execution was scheduled for. Zero for manual triggers.
+	ScheduledTime time.Time `json:"scheduledTime,omitzero"`
+	// Start is the time the execution actually started.
+	Start time.Time `json:"start"`
+	// Duration is the time the execution took, including retries.
+	// It is serialized as nanoseconds in duration and as seconds in durationSeconds.
+	Duration time.Duration `json:"duration"`
+	// Error is the error message of a failed execution. Empty if it succeeded.
+	Error string `json:"error,omitempty"`
+	// Attempt is the number of attempts made, greater than 1 if the execution was retried.
+	Attempt int `json:"attempt"`
+	// Skipped is true if the execution was dropped, because the previous one was still running
+	// or it started later than Options.MisfireThreshold.
+	Skipped bool `json:"skipped,omitempty"`
+}
+
+// MarshalJSON adds durationSeconds, the duration in seconds, to the fields of the execution.
+func (e Execution) MarshalJSON() ([]byte, error) {
+	type execution Execution
+	return json.Marshal(struct {
+		execution
+		DurationSeconds float64 `json:"durationSeconds"`
+	}{
+		execution:       execution(e),
+		DurationSeconds: e.Duration.Seconds(),
+	})
+}
+
+//counterfeiter:generate -o mocks/cron-history.go --fake-name CronHistory . History
+
+// History keeps the most recent executions of a cron.
+type History interface {
+	// Add records an execution. The oldest execution is dropped if the history is full.
+	Add(execution Execution)
+	// Executions returns the recorded executions, oldest first.
+	Executions() []Execution
+}
+
+// NewHistory returns an in-memory History keeping the last size executions.
+// A size below 1 uses DefaultHistorySize.
+func NewHistory(size int) History {
+	if size < 1 {
+		size = DefaultHistorySize
+	}
+	return &history{
+		executions: make([]Execution, size),
+	}
+}
+
+type history struct {
+	mux        sync.Mutex
+	executions []Execution
+	next       int
+	full       bool
+}
+
+func (h *history) Add(execution Execution) {
+	h.mux.Lock()
+	defer h.mux.Unlock()
+	h.executions[h.next] = execution
+	h.next = (h.next + 1) % len(h.executions)
+	if h.next == 0 {
+		h.full = true
+	}
+}
+
+func (h *history) Executions() []Execution {
+	h.mux.Lock()
+	defer h.mux.Unlock()
+	if !h.full {
+		return append([]Execution{}, h.executions[:h.next]...)
+	}
+	result := make([]Execution, 0, len(h.executions))
+	result = append(result, h.executions[h.next:]...)
+	return append(result, h.executions[:h.next]...)
+}
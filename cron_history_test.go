@@ -0,0 +1,93 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package cron_test
+
+import (
+	"encoding/json"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/bborbe/cron"
+)
+
+var _ = Describe("History", func() {
+	var history cron.History
+
+	// executionAt returns an execution starting at the given minute.
+	executionAt := func(minute int) cron.Execution {
+		return cron.Execution{
+			Start: time.Date(2026, 1, 1, 12, minute, 0, 0, time.UTC),
+		}
+	}
+
+	BeforeEach(func() {
+		history = cron.NewHistory(3)
+	})
+	It("is empty initially", func() {
+		Expect(history.Executions()).To(BeEmpty())
+		Expect(history.Executions()).NotTo(BeNil())
+	})
+	It("returns executions oldest first", func() {
+		history.Add(executionAt(1))
+		history.Add(executionAt(2))
+		Expect(history.Executions()).To(Equal([]cron.Execution{executionAt(1), executionAt(2)}))
+	})
+	It("drops the oldest execution if full", func() {
+		for i := 1; i <= 5; i++ {
+			history.Add(executionAt(i))
+		}
+		Expect(history.Executions()).To(Equal([]cron.Execution{
+			executionAt(3),
+			executionAt(4),
+			executionAt(5),
+		}))
+	})
+	It("returns a copy", func() {
+		history.Add(executionAt(1))
+		executions := history.Executions()
+		executions[0] = executionAt(9)
+		Expect(history.Executions()).To(Equal([]cron.Execution{executionAt(1)}))
+	})
+	It("uses the default size for invalid sizes", func() {
+		history = cron.NewHistory(0)
+		for i := 0; i < cron.DefaultHistorySize+5; i++ {
+			history.Add(executionAt(i))
+		}
+		Expect(history.Executions()).To(HaveLen(cron.DefaultHistorySize))
+	})
+})
+
+var _ = Describe("Execution", func() {
+	It("serializes the duration in seconds", func() {
+		data, err := json.Marshal(cron.Execution{
+			Start:    time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC),
+			Duration: 1500 * time.Millisecond,
+			Attempt:  1,
+		})
+		Expect(err).To(BeNil())
+		Expect(data).To(MatchJSON(`{
+			"start": "2026-01-01T12:00:00Z",
+			"duration": 1500000000,
+			"durationSeconds": 1.5,
+			"attempt": 1
+		}`))
+	})
+	It("reads back the serialized execution", func() {
+		execution := cron.Execution{
+			ScheduledTime: time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC),
+			Start:         time.Date(2026, 1, 1, 12, 0, 1, 0, time.UTC),
+			Duration:      2 * time.Second,
+			Error:         "banana",
+			Attempt:       2,
+		}
+		data, err := json.Marshal(execution)
+		Expect(err).To(BeNil())
+		var result cron.Execution
+		Expect(json.Unmarshal(data, &result)).To(Succeed())
+		Expect(result).To(Equal(execution))
+	})
+})
@@ -6,12 +6,10 @@ package cron
 
 import (
 	"context"
-	"time"
 
 	"github.com/bborbe/errors"
 	"github.com/bborbe/run"
 	libtime "github.com/bborbe/time"
-	"github.com/golang/glog"
 )
 
 // NewWaitCron creates a cron job that waits between runs.
@@ -28,49 +26,72 @@ func NewWaitCron(
 
 // NewIntervalCron creates a cron job that executes at fixed time intervals.
 // The job runs continuously with the specified wait duration between executions.
+// The returned cron implements Controller.
 func NewIntervalCron(
 	wait libtime.Duration,
 	action run.Runnable,
 ) run.Runnable {
+	clock := NewClock()
+	jobControl := newJobControl(Options{}, wait.Duration().String(), clock, action)
 	return &intervalCron{
-		action: action,
-		wait:   wait,
+		jobControl: jobControl,
+		action:     jobControl.skipIfPaused(run.Func(jobControl.execute)),
+		wait:       wait,
+		clock:      clock,
 	}
 }
 
 // NewIntervalCronWithOptions creates an interval-based cron job with configurable options.
 // Applies timeout, metrics, and parallel execution controls to individual action executions.
+// The returned cron implements Controller.
 func NewIntervalCronWithOptions(
 	wait libtime.Duration,
 	action run.Runnable,
 	options Options,
 ) run.Runnable {
-	return NewIntervalCron(
-		wait,
-		WrapWithOptions(
-			action,
+	clock := options.clockOrDefault()
+	jobControl := newJobControl(options, wait.Duration().String(), clock, WrapWithOptions(action, options))
+	return &intervalCron{
+		jobControl: jobControl,
+		action: wrapWithErrorPolicy(
 			options,
+			jobControl.skipIfPaused(
+				wrapWithJitter(
+					options,
+					run.Func(jobControl.execute),
+				),
+			),
 		),
-	)
+		wait:  wait,
+		clock: clock,
+	}
 }
 
 type intervalCron struct {
+	*jobControl
 	action run.Runnable
 	wait   libtime.Duration
+	clock  Clock
 }
 
 func (c *intervalCron) Run(ctx context.Context) error {
+	ctx = c.withLogger(ctx)
+	logger := LoggerFromContext(ctx)
+	defer c.shutdown(ctx)
+	scheduledTime := c.clock.Now()
 	for {
-		glog.V(4).Infof("run cron action started")
-		if err := c.action.Run(ctx); err != nil {
+		if err := c.action.Run(withScheduledTime(ctx, scheduledTime)); err != nil {
 			return errors.Wrapf(ctx, err, "run cron action failed")
 		}
-		glog.V(4).Infof("run cron action completed")
+		scheduledTime = c.clock.Now().Add(c.wait.Duration())
+		c.scheduled(ctx, scheduledTime)
+		timer := c.clock.NewTimer(c.wait.Duration())
 		select {
 		case <-ctx.Done():
+			timer.Stop()
 			return ctx.Err()
-		case <-time.NewTimer(c.wait.Duration()).C:
-			glog.V(3).Infof("wait for %v completed", c.wait)
+		case <-timer.C():
+			logger.DebugContext(ctx, "wait completed", "wait", c.wait.Duration())
 		}
 	}
 }
@@ -7,6 +7,7 @@ package cron_test
 import (
 	"context"
 	"errors"
+	"sync/atomic"
 	"time"
 
 	"github.com/bborbe/run"
@@ -80,3 +81,54 @@ var _ = Describe("CronWait", func() {
 		})
 	})
 })
+
+var _ = Describe("IntervalCron with FakeClock", func() {
+	var ctx context.Context
+	var cancel context.CancelFunc
+	var clock *libcron.FakeClock
+	var counter int64
+	var result chan error
+	BeforeEach(func() {
+		ctx, cancel = context.WithCancel(context.Background())
+		clock = libcron.NewFakeClock(time.Date(2026, 8, 13, 12, 0, 0, 0, time.UTC))
+		atomic.StoreInt64(&counter, 0)
+		result = make(chan error, 1)
+		b := libcron.NewIntervalCronWithOptions(
+			libtime.Minute,
+			run.Func(func(ctx context.Context) error {
+				atomic.AddInt64(&counter, 1)
+				return nil
+			}),
+			libcron.Options{Clock: clock},
+		)
+		go func(ctx context.Context, result chan<- error) {
+			result <- b.Run(ctx)
+		}(ctx, result)
+	})
+	AfterEach(func() {
+		cancel()
+	})
+	It("runs once without advancing the clock", func() {
+		Expect(clock.WaitForTimers(ctx, 1)).To(Succeed())
+		Expect(atomic.LoadInt64(&counter)).To(Equal(int64(1)))
+	})
+	It("runs once more per elapsed interval", func() {
+		for i := 0; i < 3; i++ {
+			Expect(clock.WaitForTimers(ctx, 1)).To(Succeed())
+			clock.Add(time.Minute)
+		}
+		Expect(clock.WaitForTimers(ctx, 1)).To(Succeed())
+		Expect(atomic.LoadInt64(&counter)).To(Equal(int64(4)))
+	})
+	It("does not run before the interval elapsed", func() {
+		Expect(clock.WaitForTimers(ctx, 1)).To(Succeed())
+		clock.Add(59 * time.Second)
+		Expect(atomic.LoadInt64(&counter)).To(Equal(int64(1)))
+	})
+	It("stops the timer on cancel", func() {
+		Expect(clock.WaitForTimers(ctx, 1)).To(Succeed())
+		cancel()
+		Eventually(result).Should(Receive(MatchError(context.Canceled)))
+		Expect(clock.Timers()).To(Equal(0))
+	})
+})
@@ -0,0 +1,56 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package cron
+
+import (
+	"context"
+	"hash/fnv"
+	"math/rand/v2"
+	"time"
+
+	"github.com/bborbe/run"
+)
+
+// wrapWithJitter delays each execution by up to options.Jitter.
+// It is applied by the recurring schedulers only, a one-time cron starts immediately.
+func wrapWithJitter(options Options, fn run.Runnable) run.Runnable {
+	if options.Jitter <= 0 {
+		return fn
+	}
+	clock := options.clockOrDefault()
+	metrics := options.enabledMetrics()
+	return run.Func(func(ctx context.Context) error {
+		delay := jitterDelay(options.Name, options.Jitter.Duration(), options.JitterDeterministic)
+		logger := jobLogger(ctx, options.Name)
+		logger.DebugContext(ctx, "delay cron by jitter", "jitter", delay)
+		metrics.SetJitter(options.Name, delay.Seconds())
+		timer := clock.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			logger.DebugContext(ctx, "jitter cancelled, skip execution")
+			return nil
+		case <-timer.C():
+		}
+		return fn.Run(context.WithValue(ctx, jitterContextKey, delay))
+	})
+}
+
+// jitterFromContext returns the jitter delay the execution was started with.
+func jitterFromContext(ctx context.Context) time.Duration {
+	jitter, _ := ctx.Value(jitterContextKey).(time.Duration)
+	return jitter
+}
+
+// jitterDelay returns a delay in [0, maxDelay). A deterministic delay is derived from the name,
+// so a job keeps its offset across restarts while jobs with different names are spread out.
+func jitterDelay(name string, maxDelay time.Duration, deterministic bool) time.Duration {
+	if deterministic {
+		hash := fnv.New64a()
+		_, _ = hash.Write([]byte(name))
+		return time.Duration(hash.Sum64() % uint64(maxDelay))
+	}
+	return time.Duration(rand.Int64N(int64(maxDelay))) //nolint:gosec // jitter needs no crypto randomness
+}
@@ -0,0 +1,165 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package cron_test
+
+import (
+	"context"
+	"sync/atomic"
+	"time"
+
+	"github.com/bborbe/run"
+	libtime "github.com/bborbe/time"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/bborbe/cron"
+)
+
+var _ = Describe("Jitter", func() {
+	var ctx context.Context
+	var cancel context.CancelFunc
+	var clock *cron.FakeClock
+	var counter int64
+	var options cron.Options
+	var result chan error
+
+	// delayOf starts an interval cron and advances the clock in steps of one second
+	// until the first execution happened. It returns the applied jitter delay.
+	delayOf := func() time.Duration {
+		b := cron.NewIntervalCronWithOptions(
+			libtime.Hour,
+			run.Func(func(ctx context.Context) error {
+				atomic.AddInt64(&counter, 1)
+				return nil
+			}),
+			options,
+		)
+		go func(ctx context.Context, result chan<- error) {
+			result <- b.Run(ctx)
+		}(ctx, result)
+		var delay time.Duration
+		for {
+			Expect(clock.WaitForTimers(ctx, 1)).To(Succeed())
+			if atomic.LoadInt64(&counter) > 0 {
+				return delay
+			}
+			clock.Add(time.Second)
+			delay += time.Second
+		}
+	}
+
+	BeforeEach(func() {
+		ctx, cancel = context.WithCancel(context.Background())
+		clock = cron.NewFakeClock(time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC))
+		atomic.StoreInt64(&counter, 0)
+		result = make(chan error, 1)
+		options = cron.Options{
+			Name:   "jitter-job",
+			Jitter: libtime.Minute,
+			Clock:  clock,
+		}
+	})
+	AfterEach(func() {
+		cancel()
+	})
+	It("delays the execution", func() {
+		options.Jitter = 10 * libtime.Second
+		options.JitterDeterministic = true
+		options.Name = "delayed-job"
+		Expect(delayOf()).To(BeNumerically(">", 0))
+	})
+	It("delays by less than the maximum", func() {
+		Expect(delayOf()).To(BeNumerically("<=", time.Minute))
+	})
+	It("runs immediately without jitter", func() {
+		options.Jitter = 0
+		Expect(delayOf()).To(Equal(time.Duration(0)))
+	})
+	It("applies the same delay for the same name if deterministic", func() {
+		options.JitterDeterministic = true
+		first := delayOf()
+		cancel()
+		Eventually(result).Should(Receive())
+
+		ctx, cancel = context.WithCancel(context.Background())
+		clock = cron.NewFakeClock(time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC))
+		options.Clock = clock
+		atomic.StoreInt64(&counter, 0)
+		Expect(delayOf()).To(Equal(first))
+	})
+	It("skips the execution if cancelled during the delay", func() {
+		options.JitterDeterministic = true
+		options.Name = "delayed-job"
+		options.Jitter = 10 * libtime.Second
+		b := cron.NewIntervalCronWithOptions(
+			libtime.Hour,
+			run.Func(func(ctx context.Context) error {
+				atomic.AddInt64(&counter, 1)
+				return nil
+			}),
+			options,
+		)
+		go func(ctx context.Context, result chan<- error) {
+			result <- b.Run(ctx)
+		}(ctx, result)
+		Expect(clock.WaitForTimers(ctx, 1)).To(Succeed())
+		cancel()
+		Eventually(result).Should(Receive(MatchError(context.Canceled)))
+		Expect(atomic.LoadInt64(&counter)).To(Equal(int64(0)))
+	})
+	It("reports the delay as metric", func() {
+		options.EnableMetrics = true
+		options.JitterDeterministic = true
+		options.Name = "jitter-metrics-cron"
+		delay := delayOf()
+		Expect(gaugeValue("cron_job_jitter_seconds", "jitter-metrics-cron")).To(
+			BeNumerically("~", delay.Seconds(), 1),
+		)
+	})
+	It("delays expression crons", func() {
+		options.JitterDeterministic = true
+		options.Name = "delayed-job"
+		options.Jitter = 10 * libtime.Second
+		executions := make(chan time.Time, 10)
+		b := cron.NewExpressionCronWithOptions(
+			"0 * * * * ?",
+			run.Func(func(ctx context.Context) error {
+				executions <- clock.Now()
+				return nil
+			}),
+			options,
+		)
+		go func(ctx context.Context, result chan<- error) {
+			result <- b.Run(ctx)
+		}(ctx, result)
+		Expect(clock.WaitForTimers(ctx, 1)).To(Succeed())
+		clock.Add(time.Minute)
+		Expect(clock.WaitForTimers(ctx, 2)).To(Succeed())
+		Consistently(executions, 50*time.Millisecond).ShouldNot(Receive())
+		clock.Add(10 * time.Second)
+		Eventually(executions).Should(Receive())
+	})
+})
+
+// gaugeValue reads the gauge with the given metric name for the given job name
+// straight off the default registry.
+func gaugeValue(metricName string, name string) float64 {
+	families, err := prometheus.DefaultGatherer.Gather()
+	Expect(err).To(BeNil())
+	for _, family := range families {
+		if family.GetName() != metricName {
+			continue
+		}
+		for _, metric := range family.GetMetric() {
+			for _, label := range metric.GetLabel() {
+				if label.GetName() == "name" && label.GetValue() == name {
+					return metric.GetGauge().GetValue()
+				}
+			}
+		}
+	}
+	return 0
+}
@@ -5,9 +5,10 @@
 package cron
 
 import (
+	"context"
+
 	"github.com/bborbe/run"
 	libtime "github.com/bborbe/time"
-	"github.com/golang/glog"
 )
 
 // NewCronJob creates a new cron job with automatic strategy selection based on parameters.
@@ -31,6 +32,7 @@ func NewCronJob(
 // NewCronJobWithOptions creates a new cron job with configurable options.
 // Applies the same strategy selection as NewCronJob but with additional wrappers for
 // timeout, metrics, and parallel execution control based on the provided options.
+// The options apply to all strategies, including duration-based intervals.
 func NewCronJobWithOptions(
 	oneTime bool,
 	expression Expression,
@@ -38,24 +40,26 @@ func NewCronJobWithOptions(
 	action run.Runnable,
 	options Options,
 ) run.Runnable {
+	logger := LoggerFromContext(withJobLogger(context.Background(), options.Logger, options.Name))
 	if oneTime {
-		glog.V(2).Infof("create one-time cron")
+		logger.Info("create one-time cron")
 		return NewOneTimeCronWithOptions(
 			action,
 			options,
 		)
 	}
 	if len(expression) > 0 {
-		glog.V(2).Infof("create cron with expression %s", expression)
+		logger.Info("create cron", "expression", expression)
 		return NewExpressionCronWithOptions(
 			expression,
 			action,
 			options,
 		)
 	}
-	glog.V(2).Infof("create cron with wait %v", wait)
-	return NewIntervalCron(
+	logger.Info("create cron", "wait", wait.Duration())
+	return NewIntervalCronWithOptions(
 		wait,
 		action,
+		options,
 	)
 }
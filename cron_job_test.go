@@ -7,6 +7,7 @@ package cron_test
 import (
 	"context"
 	"errors"
+	"sync/atomic"
 	"time"
 
 	"github.com/bborbe/run"
@@ -162,6 +163,28 @@ var _ = Describe("CronJob", func() {
 			})
 		})
 
+		Context("interval-based execution", func() {
+			It("applies the options", func() {
+				clock := cron.NewFakeClock(time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC))
+				options.Clock = clock
+				var executions atomic.Int64
+				cancelCtx, cancel := context.WithCancel(ctx)
+				defer cancel()
+				cronJob := cron.NewCronJobWithOptions(false, "", libtime.Hour, run.Func(func(ctx context.Context) error {
+					executions.Add(1)
+					return nil
+				}), options)
+				go func() {
+					_ = cronJob.Run(cancelCtx)
+				}()
+
+				Eventually(executions.Load).Should(Equal(int64(1)))
+				Expect(clock.WaitForTimers(cancelCtx, 1)).To(Succeed())
+				clock.Add(time.Hour)
+				Eventually(executions.Load).Should(Equal(int64(2)))
+			})
+		})
+
 		Context("error handling", func() {
 			BeforeEach(func() {
 				actionError = errors.New("test error")
@@ -0,0 +1,165 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package cron
+
+import (
+	"context"
+	"time"
+
+	"github.com/bborbe/run"
+)
+
+//counterfeiter:generate -o mocks/cron-listener.go --fake-name CronListener . Listener
+
+// Listener receives the lifecycle events of a cron, e.g. to notify on failures or write an audit log.
+// It is registered with Options.Listener. Callbacks run synchronously in the execution
+// and should return quickly. Embed NopListener to implement only some of them.
+type Listener interface {
+	// OnScheduled is called when a recurring cron scheduled its next execution.
+	OnScheduled(ctx context.Context, name string, next time.Time)
+	// OnStart is called before an execution starts. Retries belong to the same execution.
+	OnStart(ctx context.Context, executionInfo ExecutionInfo)
+	// OnSuccess is called after an execution succeeded.
+	OnSuccess(ctx context.Context, executionInfo ExecutionInfo, duration time.Duration)
+	// OnFailure is called after an execution failed, including all retries.
+	OnFailure(ctx context.Context, executionInfo ExecutionInfo, duration time.Duration, err error)
+	// OnSkip is called if an execution does not run at all.
+	OnSkip(ctx context.Context, executionInfo ExecutionInfo, reason SkipReason)
+	// OnTimeout is called if an attempt exceeded Options.Timeout. OnFailure follows if it is not retried.
+	OnTimeout(ctx context.Context, executionInfo ExecutionInfo, timeout time.Duration)
+	// OnShutdown is called when a cron stops. The context is not cancelled.
+	OnShutdown(ctx context.Context, name string)
+}
+
+// SkipReason tells why an execution was skipped.
+type SkipReason string
+
+const (
+	// SkipReasonPaused is reported for executions due while the cron is paused.
+	SkipReasonPaused SkipReason = "paused"
+	// SkipReasonOverlap is reported for executions dropped by the overlap policy.
+	SkipReasonOverlap SkipReason = "overlap"
+	// SkipReasonMisfire is reported for executions starting later than Options.MisfireThreshold.
+	SkipReasonMisfire SkipReason = "misfire"
+	// SkipReasonLocked is reported for executions whose lock is held by another replica.
+	SkipReasonLocked SkipReason = "locked"
+)
+
+// String returns the skip reason as a string.
+func (s SkipReason) String() string {
+	return string(s)
+}
+
+// NopListener implements Listener and ignores all events.
+type NopListener struct{}
+
+func (NopListener) OnScheduled(ctx context.Context, name string, next time.Time) {}
+
+func (NopListener) OnStart(ctx context.Context, executionInfo ExecutionInfo) {}
+
+func (NopListener) OnSuccess(ctx context.Context, executionInfo ExecutionInfo, duration time.Duration) {
+}
+
+func (NopListener) OnFailure(
+	ctx context.Context,
+	executionInfo ExecutionInfo,
+	duration time.Duration,
+	err error,
+) {
+}
+
+func (NopListener) OnSkip(ctx context.Context, executionInfo ExecutionInfo, reason SkipReason) {}
+
+func (NopListener) OnTimeout(ctx context.Context, executionInfo ExecutionInfo, timeout time.Duration) {
+}
+
+func (NopListener) OnShutdown(ctx context.Context, name string) {}
+
+// Listeners passes every event to all listeners in order.
+type Listeners []Listener
+
+func (l Listeners) OnScheduled(ctx context.Context, name string, next time.Time) {
+	for _, listener := range l {
+		listener.OnScheduled(ctx, name, next)
+	}
+}
+
+func (l Listeners) OnStart(ctx context.Context, executionInfo ExecutionInfo) {
+	for _, listener := range l {
+		listener.OnStart(ctx, executionInfo)
+	}
+}
+
+func (l Listeners) OnSuccess(ctx context.Context, executionInfo ExecutionInfo, duration time.Duration) {
+	for _, listener := range l {
+		listener.OnSuccess(ctx, executionInfo, duration)
+	}
+}
+
+func (l Listeners) OnFailure(
+	ctx context.Context,
+	executionInfo ExecutionInfo,
+	duration time.Duration,
+	err error,
+) {
+	for _, listener := range l {
+		listener.OnFailure(ctx, executionInfo, duration, err)
+	}
+}
+
+func (l Listeners) OnSkip(ctx context.Context, executionInfo ExecutionInfo, reason SkipReason) {
+	for _, listener := range l {
+		listener.OnSkip(ctx, executionInfo, reason)
+	}
+}
+
+func (l Listeners) OnTimeout(ctx context.Context, executionInfo ExecutionInfo, timeout time.Duration) {
+	for _, listener := range l {
+		listener.OnTimeout(ctx, executionInfo, timeout)
+	}
+}
+
+func (l Listeners) OnShutdown(ctx context.Context, name string) {
+	for _, listener := range l {
+		listener.OnShutdown(ctx, name)
+	}
+}
+
+// WrapWithListener wraps a runnable and reports the start, success and failure of every
+// execution to the listener. It is applied by WrapWithOptions if Options.Listener is set.
+func WrapWithListener(name string, listener Listener, fn run.Runnable) run.Runnable {
+	return wrapWithListener(name, listener, NewClock(), fn)
+}
+
+// wrapWithListener works like WrapWithListener and measures durations with the given clock.
+func wrapWithListener(name string, listener Listener, clock Clock, fn run.Runnable) run.Runnable {
+	return run.Func(func(ctx context.Context) error {
+		executionInfo := currentExecution(ctx, name, clock)
+		listener.OnStart(ctx, executionInfo)
+		start := clock.Now()
+		err := fn.Run(ctx)
+		duration := clock.Now().Sub(start)
+		if err != nil {
+			listener.OnFailure(ctx, executionInfo, duration, err)
+			return err
+		}
+		listener.OnSuccess(ctx, executionInfo, duration)
+		return nil
+	})
+}
+
+// currentExecution returns the ExecutionInfo of the context. Outside of a cron,
+// e.g. if a wrapped action is run directly, it describes an execution starting now.
+func currentExecution(ctx context.Context, name string, clock Clock) ExecutionInfo {
+	if executionInfo, ok := ExecutionFromContext(ctx); ok {
+		return executionInfo
+	}
+	return ExecutionInfo{
+		Name:          name,
+		ScheduledTime: scheduledTimeFromContext(ctx),
+		Start:         clock.Now(),
+		Attempt:       1,
+	}
+}
@@ -0,0 +1,199 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package cron_test
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/bborbe/run"
+	libtime "github.com/bborbe/time"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/bborbe/cron"
+	"github.com/bborbe/cron/mocks"
+)
+
+var _ = Describe("Listener", func() {
+	var ctx context.Context
+	var cancel context.CancelFunc
+	var listener *mocks.CronListener
+
+	BeforeEach(func() {
+		ctx, cancel = context.WithCancel(context.Background())
+		listener = &mocks.CronListener{}
+	})
+	AfterEach(func() {
+		cancel()
+	})
+
+	Context("WrapWithListener", func() {
+		It("reports start and success", func() {
+			fn := cron.WrapWithListener("listener-job", listener, run.Func(func(ctx context.Context) error {
+				return nil
+			}))
+			Expect(fn.Run(ctx)).To(Succeed())
+
+			Expect(listener.OnStartCallCount()).To(Equal(1))
+			_, executionInfo := listener.OnStartArgsForCall(0)
+			Expect(executionInfo.Name).To(Equal("listener-job"))
+			Expect(executionInfo.Attempt).To(Equal(1))
+			Expect(listener.OnSuccessCallCount()).To(Equal(1))
+			Expect(listener.OnFailureCallCount()).To(Equal(0))
+		})
+
+		It("reports failures", func() {
+			fn := cron.WrapWithListener("listener-job", listener, run.Func(func(ctx context.Context) error {
+				return errors.New("banana")
+			}))
+			Expect(fn.Run(ctx)).NotTo(Succeed())
+
+			Expect(listener.OnSuccessCallCount()).To(Equal(0))
+			Expect(listener.OnFailureCallCount()).To(Equal(1))
+			_, executionInfo, _, err := listener.OnFailureArgsForCall(0)
+			Expect(executionInfo.Name).To(Equal("listener-job"))
+			Expect(err).To(MatchError("banana"))
+		})
+	})
+
+	Context("Listeners", func() {
+		It("passes events to all listeners", func() {
+			other := &mocks.CronListener{}
+			listeners := cron.Listeners{listener, other, cron.NopListener{}}
+			listeners.OnShutdown(ctx, "listener-job")
+			listeners.OnSkip(ctx, cron.ExecutionInfo{}, cron.SkipReasonPaused)
+
+			Expect(listener.OnShutdownCallCount()).To(Equal(1))
+			Expect(other.OnShutdownCallCount()).To(Equal(1))
+			Expect(listener.OnSkipCallCount()).To(Equal(1))
+			Expect(other.OnSkipCallCount()).To(Equal(1))
+		})
+	})
+
+	Context("Options.Listener", func() {
+		var options cron.Options
+
+		BeforeEach(func() {
+			options = cron.Options{
+				Name:     "listener-job",
+				Listener: listener,
+			}
+		})
+
+		It("reports executions of one-time crons with execution info", func() {
+			b := cron.NewOneTimeCronWithOptions(run.Func(func(ctx context.Context) error {
+				return nil
+			}), options)
+			Expect(b.Run(ctx)).To(Succeed())
+
+			Expect(listener.OnStartCallCount()).To(Equal(1))
+			_, executionInfo := listener.OnStartArgsForCall(0)
+			Expect(executionInfo.Name).To(Equal("listener-job"))
+			Expect(executionInfo.RunID).NotTo(BeEmpty())
+			Expect(listener.OnSuccessCallCount()).To(Equal(1))
+			_, successInfo, _ := listener.OnSuccessArgsForCall(0)
+			Expect(successInfo.RunID).To(Equal(executionInfo.RunID))
+			Expect(listener.OnShutdownCallCount()).To(Equal(1))
+		})
+
+		It("reports scheduled executions and shutdown of interval crons", func() {
+			clock := cron.NewFakeClock(time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC))
+			options.Clock = clock
+			b := cron.NewIntervalCronWithOptions(libtime.Hour, run.Func(func(ctx context.Context) error {
+				return nil
+			}), options)
+			done := make(chan error, 1)
+			go func() {
+				done <- b.Run(ctx)
+			}()
+			Expect(clock.WaitForTimers(ctx, 1)).To(Succeed())
+
+			Expect(listener.OnStartCallCount()).To(Equal(1))
+			_, executionInfo := listener.OnStartArgsForCall(0)
+			Expect(executionInfo.Schedule).To(Equal("1h0m0s"))
+			Expect(executionInfo.ScheduledTime).To(BeTemporally("==", time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)))
+			Expect(listener.OnScheduledCallCount()).To(Equal(1))
+			_, name, next := listener.OnScheduledArgsForCall(0)
+			Expect(name).To(Equal("listener-job"))
+			Expect(next).To(BeTemporally("==", time.Date(2026, 1, 1, 13, 0, 0, 0, time.UTC)))
+
+			cancel()
+			Eventually(done).Should(Receive())
+			Expect(listener.OnShutdownCallCount()).To(Equal(1))
+			shutdownCtx, name := listener.OnShutdownArgsForCall(0)
+			Expect(name).To(Equal("listener-job"))
+			Expect(shutdownCtx.Err()).To(BeNil())
+		})
+
+		It("reports shutdown of expression crons with an invalid expression", func() {
+			b := cron.NewExpressionCronWithOptions("banana", run.Func(func(ctx context.Context) error {
+				return nil
+			}), options)
+			Expect(b.Run(ctx)).NotTo(Succeed())
+			Expect(listener.OnShutdownCallCount()).To(Equal(1))
+		})
+
+		It("reports executions skipped while paused", func() {
+			clock := cron.NewFakeClock(time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC))
+			options.Clock = clock
+			b := cron.NewIntervalCronWithOptions(libtime.Hour, run.Func(func(ctx context.Context) error {
+				return nil
+			}), options)
+			b.(cron.Controller).Pause()
+			go func() {
+				_ = b.Run(ctx)
+			}()
+			Expect(clock.WaitForTimers(ctx, 1)).To(Succeed())
+
+			Expect(listener.OnStartCallCount()).To(Equal(0))
+			Expect(listener.OnSkipCallCount()).To(Equal(1))
+			_, executionInfo, reason := listener.OnSkipArgsForCall(0)
+			Expect(reason).To(Equal(cron.SkipReasonPaused))
+			Expect(executionInfo.Name).To(Equal("listener-job"))
+			Expect(executionInfo.Schedule).To(Equal("1h0m0s"))
+		})
+
+		It("reports executions skipped by the overlap policy", func() {
+			options.OverlapPolicy = cron.OverlapPolicySkip
+			started := make(chan struct{})
+			release := make(chan struct{})
+			fn := cron.WrapWithOptions(run.Func(func(ctx context.Context) error {
+				close(started)
+				<-release
+				return nil
+			}), options)
+			done := make(chan error, 1)
+			go func() {
+				done <- fn.Run(ctx)
+			}()
+			Eventually(started).Should(BeClosed())
+
+			Expect(fn.Run(ctx)).To(Succeed())
+			close(release)
+			Eventually(done).Should(Receive(BeNil()))
+
+			Expect(listener.OnSkipCallCount()).To(Equal(1))
+			_, _, reason := listener.OnSkipArgsForCall(0)
+			Expect(reason).To(Equal(cron.SkipReasonOverlap))
+		})
+
+		It("reports timeouts followed by the failure", func() {
+			options.Timeout = 10 * libtime.Millisecond
+			fn := cron.WrapWithOptions(run.Func(func(ctx context.Context) error {
+				<-ctx.Done()
+				return ctx.Err()
+			}), options)
+			Expect(fn.Run(ctx)).NotTo(Succeed())
+
+			Expect(listener.OnTimeoutCallCount()).To(Equal(1))
+			_, executionInfo, timeout := listener.OnTimeoutArgsForCall(0)
+			Expect(executionInfo.Name).To(Equal("listener-job"))
+			Expect(timeout).To(Equal(10 * time.Millisecond))
+			Expect(listener.OnFailureCallCount()).To(Equal(1))
+		})
+	})
+})
@@ -0,0 +1,121 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build unix
+
+package cron
+
+import (
+	"context"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/bborbe/errors"
+)
+
+// NewFileLocker returns a Locker holding flock(2) locks on the files <dir>/<name>.lock,
+// e.g. on a volume shared by replicas on the same host. The name is path escaped, so it
+// cannot leave dir. The kernel releases a lock when the process dies, so the ttl is not
+// needed and ignored. Release removes the file.
+func NewFileLocker(dir string) Locker {
+	return &fileLocker{
+		dir:   dir,
+		locks: make(map[string]fileLock),
+	}
+}
+
+type fileLocker struct {
+	dir   string
+	mux   sync.Mutex
+	locks map[string]fileLock
+}
+
+type fileLock struct {
+	owner string
+	file  *os.File
+}
+
+func (f *fileLocker) Acquire(ctx context.Context, name string, owner string, ttl time.Duration) (bool, error) {
+	f.mux.Lock()
+	defer f.mux.Unlock()
+	if _, ok := f.locks[name]; ok {
+		return false, nil
+	}
+	if err := os.MkdirAll(f.dir, 0o750); err != nil {
+		return false, errors.Wrapf(ctx, err, "create lock dir '%s' failed", f.dir)
+	}
+	path := f.path(name)
+	for {
+		file, err := os.OpenFile(path, os.O_CREATE|os.O_RDWR, 0o600)
+		if err != nil {
+			return false, errors.Wrapf(ctx, err, "open lock file '%s' failed", path)
+		}
+		if err := syscall.Flock(int(file.Fd()), syscall.LOCK_EX|syscall.LOCK_NB); err != nil {
+			_ = file.Close()
+			if errors.Is(err, syscall.EWOULDBLOCK) {
+				return false, nil
+			}
+			return false, errors.Wrapf(ctx, err, "lock file '%s' failed", path)
+		}
+		// the holder may have removed the file on release after we opened it, lock the new one then
+		if !sameFile(path, file) {
+			_ = file.Close()
+			continue
+		}
+		f.locks[name] = fileLock{
+			owner: owner,
+			file:  file,
+		}
+		return true, nil
+	}
+}
+
+func (f *fileLocker) Refresh(ctx context.Context, name string, owner string, ttl time.Duration) error {
+	f.mux.Lock()
+	defer f.mux.Unlock()
+	if lock, ok := f.locks[name]; !ok || lock.owner != owner {
+		return errors.Errorf(ctx, "lock '%s' is not held by '%s'", name, owner)
+	}
+	return nil
+}
+
+func (f *fileLocker) Release(ctx context.Context, name string, owner string) error {
+	f.mux.Lock()
+	defer f.mux.Unlock()
+	lock, ok := f.locks[name]
+	if !ok || lock.owner != owner {
+		return nil
+	}
+	delete(f.locks, name)
+	// remove while locked, so no one holds a lock on a removed file; closing releases the lock
+	if err := os.Remove(f.path(name)); err != nil && !os.IsNotExist(err) {
+		_ = lock.file.Close()
+		return errors.Wrapf(ctx, err, "remove lock file of '%s' failed", name)
+	}
+	if err := lock.file.Close(); err != nil {
+		return errors.Wrapf(ctx, err, "close lock file of '%s' failed", name)
+	}
+	return nil
+}
+
+func (f *fileLocker) path(name string) string {
+	return filepath.Join(f.dir, url.PathEscape(name)+".lock")
+}
+
+// sameFile reports whether path still refers to the open file.
+func sameFile(path string, file *os.File) bool {
+	pathInfo, err := os.Stat(path)
+	if err != nil {
+		return false
+	}
+	fileInfo, err := file.Stat()
+	if err != nil {
+		return false
+	}
+	return os.SameFile(pathInfo, fileInfo)
+}
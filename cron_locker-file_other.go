@@ -0,0 +1,34 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build !unix
+
+package cron
+
+import (
+	"context"
+	"time"
+
+	"github.com/bborbe/errors"
+)
+
+// NewFileLocker returns a Locker holding flock(2) locks on the files <dir>/<name>.lock.
+// flock is not available on this platform, every Acquire fails.
+func NewFileLocker(dir string) Locker {
+	return fileLocker{}
+}
+
+type fileLocker struct{}
+
+func (fileLocker) Acquire(ctx context.Context, name string, owner string, ttl time.Duration) (bool, error) {
+	return false, errors.Errorf(ctx, "file locker is not supported on this platform")
+}
+
+func (fileLocker) Refresh(ctx context.Context, name string, owner string, ttl time.Duration) error {
+	return errors.Errorf(ctx, "file locker is not supported on this platform")
+}
+
+func (fileLocker) Release(ctx context.Context, name string, owner string) error {
+	return nil
+}
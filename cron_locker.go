@@ -0,0 +1,99 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package cron
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/bborbe/errors"
+)
+
+//counterfeiter:generate -o mocks/cron-locker.go --fake-name CronLocker . Locker
+
+// Locker takes named locks shared by all replicas of a job, so only one of them runs an execution.
+// A lock is owned by the token passed to Acquire, which is unique per acquisition. Implementations
+// for Redis, etcd or Postgres only have to store the owner and the expiry per name.
+type Locker interface {
+	// Acquire takes the lock for the given name for owner, valid for ttl.
+	// It returns false without error if the lock is held, including by the same owner.
+	Acquire(ctx context.Context, name string, owner string, ttl time.Duration) (bool, error)
+	// Refresh extends the lock held by owner to ttl from now.
+	// It returns an error if the lock is not held by owner anymore.
+	Refresh(ctx context.Context, name string, owner string, ttl time.Duration) error
+	// Release gives up the lock if it is still held by owner.
+	Release(ctx context.Context, name string, owner string) error
+}
+
+// NewMemoryLocker returns a Locker keeping the locks in memory.
+// It only excludes executions within the process, e.g. crons sharing a job name.
+// Expired locks can be acquired again. A nil clock uses NewClock.
+func NewMemoryLocker(clock Clock) Locker {
+	if clock == nil {
+		clock = NewClock()
+	}
+	return &memoryLocker{
+		clock: clock,
+		locks: make(map[string]memoryLock),
+	}
+}
+
+type memoryLocker struct {
+	clock Clock
+	mux   sync.Mutex
+	locks map[string]memoryLock
+}
+
+type memoryLock struct {
+	owner  string
+	expiry time.Time
+}
+
+func (m *memoryLocker) Acquire(ctx context.Context, name string, owner string, ttl time.Duration) (bool, error) {
+	m.mux.Lock()
+	defer m.mux.Unlock()
+	now := m.clock.Now()
+	m.removeExpired(now)
+	if _, ok := m.locks[name]; ok {
+		return false, nil
+	}
+	m.locks[name] = memoryLock{
+		owner:  owner,
+		expiry: now.Add(ttl),
+	}
+	return true, nil
+}
+
+func (m *memoryLocker) Refresh(ctx context.Context, name string, owner string, ttl time.Duration) error {
+	m.mux.Lock()
+	defer m.mux.Unlock()
+	now := m.clock.Now()
+	lock, ok := m.locks[name]
+	if !ok || lock.owner != owner || !lock.expiry.After(now) {
+		return errors.Errorf(ctx, "lock '%s' is not held by '%s'", name, owner)
+	}
+	lock.expiry = now.Add(ttl)
+	m.locks[name] = lock
+	return nil
+}
+
+func (m *memoryLocker) Release(ctx context.Context, name string, owner string) error {
+	m.mux.Lock()
+	defer m.mux.Unlock()
+	if lock, ok := m.locks[name]; ok && lock.owner == owner {
+		delete(m.locks, name)
+	}
+	return nil
+}
+
+// removeExpired drops expired locks, so locks never released by their owner do not pile up.
+func (m *memoryLocker) removeExpired(now time.Time) {
+	for name, lock := range m.locks {
+		if !lock.expiry.After(now) {
+			delete(m.locks, name)
+		}
+	}
+}
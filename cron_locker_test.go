@@ -0,0 +1,138 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package cron_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/bborbe/cron"
+)
+
+var _ = Describe("Locker", func() {
+	var ctx context.Context
+
+	BeforeEach(func() {
+		ctx = context.Background()
+	})
+
+	Context("NewMemoryLocker", func() {
+		var clock *cron.FakeClock
+		var locker cron.Locker
+
+		BeforeEach(func() {
+			clock = cron.NewFakeClock(time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC))
+			locker = cron.NewMemoryLocker(clock)
+		})
+
+		It("acquires a free lock once", func() {
+			Expect(locker.Acquire(ctx, "job", "owner", time.Minute)).To(BeTrue())
+			Expect(locker.Acquire(ctx, "job", "owner", time.Minute)).To(BeFalse())
+			Expect(locker.Acquire(ctx, "other-job", "owner", time.Minute)).To(BeTrue())
+		})
+
+		It("acquires a released lock", func() {
+			Expect(locker.Acquire(ctx, "job", "owner", time.Minute)).To(BeTrue())
+			Expect(locker.Release(ctx, "job", "owner")).To(Succeed())
+			Expect(locker.Acquire(ctx, "job", "owner", time.Minute)).To(BeTrue())
+		})
+
+		It("acquires an expired lock", func() {
+			Expect(locker.Acquire(ctx, "job", "owner", time.Minute)).To(BeTrue())
+			clock.Add(time.Minute)
+			Expect(locker.Acquire(ctx, "job", "owner", time.Minute)).To(BeTrue())
+		})
+
+		It("extends the lock on refresh", func() {
+			Expect(locker.Acquire(ctx, "job", "owner", time.Minute)).To(BeTrue())
+			clock.Add(30 * time.Second)
+			Expect(locker.Refresh(ctx, "job", "owner", time.Minute)).To(Succeed())
+			clock.Add(45 * time.Second)
+			Expect(locker.Acquire(ctx, "job", "owner", time.Minute)).To(BeFalse())
+		})
+
+		It("fails to refresh an expired lock", func() {
+			Expect(locker.Acquire(ctx, "job", "owner", time.Minute)).To(BeTrue())
+			clock.Add(time.Minute)
+			Expect(locker.Refresh(ctx, "job", "owner", time.Minute)).NotTo(Succeed())
+		})
+
+		It("refreshes and releases for the owner only", func() {
+			Expect(locker.Acquire(ctx, "job", "owner", time.Minute)).To(BeTrue())
+			Expect(locker.Refresh(ctx, "job", "other-owner", time.Minute)).NotTo(Succeed())
+			Expect(locker.Release(ctx, "job", "other-owner")).To(Succeed())
+			Expect(locker.Acquire(ctx, "job", "other-owner", time.Minute)).To(BeFalse())
+		})
+
+		It("keeps the lock of the next owner after it expired", func() {
+			Expect(locker.Acquire(ctx, "job", "owner", time.Minute)).To(BeTrue())
+			clock.Add(time.Minute)
+			Expect(locker.Acquire(ctx, "job", "other-owner", time.Minute)).To(BeTrue())
+			Expect(locker.Release(ctx, "job", "owner")).To(Succeed())
+			Expect(locker.Acquire(ctx, "job", "owner", time.Minute)).To(BeFalse())
+		})
+	})
+
+	Context("NewFileLocker", func() {
+		var dir string
+		var locker cron.Locker
+		var otherReplica cron.Locker
+
+		BeforeEach(func() {
+			dir = filepath.Join(GinkgoT().TempDir(), "locks")
+			locker = cron.NewFileLocker(dir)
+			otherReplica = cron.NewFileLocker(dir)
+		})
+
+		It("excludes other lockers on the same dir", func() {
+			Expect(locker.Acquire(ctx, "job", "owner", time.Minute)).To(BeTrue())
+			Expect(otherReplica.Acquire(ctx, "job", "other-owner", time.Minute)).To(BeFalse())
+			Expect(locker.Acquire(ctx, "job", "owner", time.Minute)).To(BeFalse())
+			Expect(otherReplica.Acquire(ctx, "other-job", "other-owner", time.Minute)).To(BeTrue())
+			Expect(filepath.Join(dir, "job.lock")).To(BeAnExistingFile())
+		})
+
+		It("can be acquired by others after release", func() {
+			Expect(locker.Acquire(ctx, "job", "owner", time.Minute)).To(BeTrue())
+			Expect(locker.Release(ctx, "job", "owner")).To(Succeed())
+			Expect(otherReplica.Acquire(ctx, "job", "other-owner", time.Minute)).To(BeTrue())
+		})
+
+		It("refreshes held locks only", func() {
+			Expect(locker.Refresh(ctx, "job", "owner", time.Minute)).NotTo(Succeed())
+			Expect(locker.Acquire(ctx, "job", "owner", time.Minute)).To(BeTrue())
+			Expect(locker.Refresh(ctx, "job", "owner", time.Minute)).To(Succeed())
+		})
+
+		It("releases for the owner only and removes the file", func() {
+			Expect(locker.Acquire(ctx, "job", "owner", time.Minute)).To(BeTrue())
+			Expect(locker.Refresh(ctx, "job", "other-owner", time.Minute)).NotTo(Succeed())
+			Expect(locker.Release(ctx, "job", "other-owner")).To(Succeed())
+			Expect(otherReplica.Acquire(ctx, "job", "other-owner", time.Minute)).To(BeFalse())
+			Expect(locker.Release(ctx, "job", "owner")).To(Succeed())
+			Expect(filepath.Join(dir, "job.lock")).NotTo(BeAnExistingFile())
+		})
+
+		It("keeps lock files of names with separators in the dir", func() {
+			Expect(locker.Acquire(ctx, "../job", "owner", time.Minute)).To(BeTrue())
+			Expect(locker.Acquire(ctx, "a/b", "owner", time.Minute)).To(BeTrue())
+			Expect(filepath.Join(dir, "..%2Fjob.lock")).To(BeAnExistingFile())
+			Expect(filepath.Join(dir, "a%2Fb.lock")).To(BeAnExistingFile())
+			Expect(filepath.Join(filepath.Dir(dir), "job.lock")).NotTo(BeAnExistingFile())
+		})
+
+		It("returns an error if the dir cannot be created", func() {
+			file := filepath.Join(GinkgoT().TempDir(), "file")
+			Expect(os.WriteFile(file, nil, 0o600)).To(Succeed())
+			_, err := cron.NewFileLocker(file).Acquire(ctx, "job", "owner", time.Minute)
+			Expect(err).To(HaveOccurred())
+		})
+	})
+})
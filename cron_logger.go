@@ -0,0 +1,203 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package cron
+
+import (
+	"context"
+	"log/slog"
+	"strings"
+
+	"github.com/bborbe/run"
+	"github.com/golang/glog"
+)
+
+// Keys of the structured fields logged by crons and wrappers.
+const (
+	LogKeyJob           = "job"
+	LogKeyRunID         = "run_id"
+	LogKeyScheduledTime = "scheduled_time"
+	LogKeyDuration      = "duration"
+	LogKeyError         = "error"
+)
+
+// LevelTrace is logged for every step of an execution, below slog.LevelDebug.
+const LevelTrace = slog.LevelDebug - 4
+
+const loggerContextKey contextKey = "logger"
+
+// defaultLogger writes to glog and is used if neither Options.Logger nor ContextWithLogger is set.
+var defaultLogger = slog.New(NewGlogHandler())
+
+// contextLogger is the logger of a context, the logger it was derived from
+// and the job name it is scoped to, if any.
+type contextLogger struct {
+	logger *slog.Logger
+	base   *slog.Logger
+	job    string
+}
+
+// ContextWithLogger returns a context whose crons and wrappers log to the given logger.
+// Options.Logger takes precedence for crons created with options.
+func ContextWithLogger(ctx context.Context, logger *slog.Logger) context.Context {
+	return context.WithValue(ctx, loggerContextKey, contextLogger{logger: logger, base: logger})
+}
+
+// LoggerFromContext returns the logger of the context, including the fields of the
+// current job and execution. Without logger it returns the default logger writing to glog.
+func LoggerFromContext(ctx context.Context) *slog.Logger {
+	return jobLogger(ctx, "")
+}
+
+// WrapWithLogger wraps a runnable so it and all wrappers inside log to the given logger
+// with the job name attached. It is applied by WrapWithOptions if Options.Logger is set.
+func WrapWithLogger(name string, logger *slog.Logger, fn run.Runnable) run.Runnable {
+	return run.Func(func(ctx context.Context) error {
+		return fn.Run(withJobLogger(ctx, logger, name))
+	})
+}
+
+// jobLogger returns the logger of the context with the given job name attached,
+// unless the logger carries it already.
+func jobLogger(ctx context.Context, name string) *slog.Logger {
+	current, ok := ctx.Value(loggerContextKey).(contextLogger)
+	if !ok {
+		current = contextLogger{logger: defaultLogger}
+	}
+	if name == "" || current.job == name {
+		return current.logger
+	}
+	return current.logger.With(LogKeyJob, name)
+}
+
+// withJobLogger returns a context whose logger carries the job name.
+// A nil logger uses the logger of the context. The context is returned unchanged
+// if it is scoped to the same logger and job already, keeping the fields of the execution.
+func withJobLogger(ctx context.Context, logger *slog.Logger, name string) context.Context {
+	current, ok := ctx.Value(loggerContextKey).(contextLogger)
+	if logger == nil {
+		if ok && current.job == name {
+			return ctx
+		}
+		return context.WithValue(ctx, loggerContextKey, contextLogger{
+			logger: jobLogger(ctx, name),
+			base:   current.base,
+			job:    name,
+		})
+	}
+	if ok && current.base == logger && current.job == name {
+		return ctx
+	}
+	scoped := logger
+	if name != "" {
+		scoped = logger.With(LogKeyJob, name)
+	}
+	return context.WithValue(ctx, loggerContextKey, contextLogger{logger: scoped, base: logger, job: name})
+}
+
+// withLoggerAttrs returns a context whose logger carries the given fields in addition.
+func withLoggerAttrs(ctx context.Context, name string, args ...any) context.Context {
+	return context.WithValue(ctx, loggerContextKey, contextLogger{
+		logger: jobLogger(ctx, name).With(args...),
+		base:   baseLogger(ctx),
+		job:    name,
+	})
+}
+
+// baseLogger returns the logger the logger of the context was derived from.
+func baseLogger(ctx context.Context) *slog.Logger {
+	current, _ := ctx.Value(loggerContextKey).(contextLogger)
+	return current.base
+}
+
+// glogCallDepth skips the slog frames between the caller and Handle.
+const glogCallDepth = 3
+
+// NewGlogHandler returns a slog.Handler writing to glog, the default backend of this package.
+// Warnings and errors are always written, slog.LevelInfo requires -v=2,
+// slog.LevelDebug -v=3 and LevelTrace -v=4. Fields are appended as key=value.
+func NewGlogHandler() slog.Handler {
+	return &glogHandler{}
+}
+
+type glogHandler struct {
+	attrs  string
+	prefix string
+}
+
+func (h *glogHandler) Enabled(_ context.Context, level slog.Level) bool {
+	switch {
+	case level >= slog.LevelWarn:
+		return true
+	case level >= slog.LevelInfo:
+		return bool(glog.V(2))
+	case level >= slog.LevelDebug:
+		return bool(glog.V(3))
+	default:
+		return bool(glog.V(4))
+	}
+}
+
+func (h *glogHandler) Handle(_ context.Context, record slog.Record) error {
+	var sb strings.Builder
+	sb.WriteString(record.Message)
+	sb.WriteString(h.attrs)
+	record.Attrs(func(attr slog.Attr) bool {
+		writeAttr(&sb, h.prefix, attr)
+		return true
+	})
+	switch {
+	case record.Level >= slog.LevelError:
+		glog.ErrorDepth(glogCallDepth, sb.String())
+	case record.Level >= slog.LevelWarn:
+		glog.WarningDepth(glogCallDepth, sb.String())
+	default:
+		glog.InfoDepth(glogCallDepth, sb.String())
+	}
+	return nil
+}
+
+func (h *glogHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	var sb strings.Builder
+	sb.WriteString(h.attrs)
+	for _, attr := range attrs {
+		writeAttr(&sb, h.prefix, attr)
+	}
+	return &glogHandler{
+		attrs:  sb.String(),
+		prefix: h.prefix,
+	}
+}
+
+func (h *glogHandler) WithGroup(name string) slog.Handler {
+	if name == "" {
+		return h
+	}
+	return &glogHandler{
+		attrs:  h.attrs,
+		prefix: h.prefix + name + ".",
+	}
+}
+
+// writeAttr appends the attribute as key=value, groups are flattened with dots.
+func writeAttr(sb *strings.Builder, prefix string, attr slog.Attr) {
+	attr.Value = attr.Value.Resolve()
+	if attr.Equal(slog.Attr{}) {
+		return
+	}
+	if attr.Value.Kind() == slog.KindGroup {
+		if attr.Key != "" {
+			prefix += attr.Key + "."
+		}
+		for _, groupAttr := range attr.Value.Group() {
+			writeAttr(sb, prefix, groupAttr)
+		}
+		return
+	}
+	sb.WriteString(" ")
+	sb.WriteString(prefix)
+	sb.WriteString(attr.Key)
+	sb.WriteString("=")
+	sb.WriteString(attr.Value.String())
+}
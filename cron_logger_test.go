@@ -0,0 +1,178 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package cron_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/bborbe/run"
+	libtime "github.com/bborbe/time"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/bborbe/cron"
+)
+
+// logBuffer collects the JSON lines of a slog.JSONHandler and is safe for concurrent use.
+type logBuffer struct {
+	mux    sync.Mutex
+	buffer bytes.Buffer
+}
+
+func (b *logBuffer) Write(p []byte) (int, error) {
+	b.mux.Lock()
+	defer b.mux.Unlock()
+	return b.buffer.Write(p)
+}
+
+// Entries returns the logged records with the given message.
+func (b *logBuffer) Entries(msg string) []map[string]any {
+	b.mux.Lock()
+	defer b.mux.Unlock()
+	var result []map[string]any
+	for _, line := range strings.Split(strings.TrimSpace(b.buffer.String()), "\n") {
+		if line == "" {
+			continue
+		}
+		var entry map[string]any
+		Expect(json.Unmarshal([]byte(line), &entry)).To(Succeed())
+		if entry[slog.MessageKey] == msg {
+			result = append(result, entry)
+		}
+	}
+	return result
+}
+
+var _ = Describe("Logger", func() {
+	var ctx context.Context
+	var cancel context.CancelFunc
+	var buffer *logBuffer
+	var logger *slog.Logger
+	var action run.Runnable
+
+	BeforeEach(func() {
+		ctx, cancel = context.WithCancel(context.Background())
+		buffer = &logBuffer{}
+		logger = slog.New(slog.NewJSONHandler(buffer, &slog.HandlerOptions{
+			Level: cron.LevelTrace,
+		}))
+		action = run.Func(func(ctx context.Context) error {
+			cron.LoggerFromContext(ctx).InfoContext(ctx, "action executed")
+			return nil
+		})
+	})
+	AfterEach(func() {
+		cancel()
+	})
+
+	It("returns the default logger without logger in the context", func() {
+		Expect(cron.LoggerFromContext(ctx)).NotTo(BeNil())
+	})
+
+	It("logs job name and run id of one-time crons to Options.Logger", func() {
+		b := cron.NewOneTimeCronWithOptions(action, cron.Options{
+			Name:   "logger-job",
+			Logger: logger,
+		})
+		Expect(b.Run(ctx)).To(Succeed())
+
+		entries := buffer.Entries("action executed")
+		Expect(entries).To(HaveLen(1))
+		Expect(entries[0]).To(HaveKeyWithValue(cron.LogKeyJob, "logger-job"))
+		Expect(entries[0]).To(HaveKeyWithValue(cron.LogKeyRunID, Not(BeEmpty())))
+
+		completed := buffer.Entries("run cron action completed")
+		Expect(completed).To(HaveLen(1))
+		Expect(completed[0]).To(HaveKeyWithValue(cron.LogKeyJob, "logger-job"))
+		Expect(completed[0]).To(HaveKeyWithValue(cron.LogKeyRunID, entries[0][cron.LogKeyRunID]))
+		Expect(completed[0]).To(HaveKey(cron.LogKeyDuration))
+	})
+
+	It("logs the error of failed executions", func() {
+		b := cron.NewOneTimeCronWithOptions(
+			run.Func(func(ctx context.Context) error {
+				return errors.New("banana")
+			}),
+			cron.Options{
+				Name:   "logger-job",
+				Logger: logger,
+			},
+		)
+		Expect(b.Run(ctx)).NotTo(Succeed())
+
+		entries := buffer.Entries("run cron action failed")
+		Expect(entries).To(HaveLen(1))
+		Expect(entries[0]).To(HaveKeyWithValue(cron.LogKeyError, "banana"))
+	})
+
+	It("logs the scheduled time of interval crons", func() {
+		clock := cron.NewFakeClock(time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC))
+		b := cron.NewIntervalCronWithOptions(libtime.Hour, action, cron.Options{
+			Name:   "logger-job",
+			Logger: logger,
+			Clock:  clock,
+		})
+		go func() {
+			_ = b.Run(ctx)
+		}()
+		Eventually(func() []map[string]any {
+			return buffer.Entries("action executed")
+		}).Should(HaveLen(1))
+
+		entry := buffer.Entries("action executed")[0]
+		Expect(entry).To(HaveKeyWithValue(cron.LogKeyJob, "logger-job"))
+		Expect(entry).To(HaveKeyWithValue(cron.LogKeyRunID, Not(BeEmpty())))
+		Expect(entry).To(HaveKeyWithValue(cron.LogKeyScheduledTime, "2026-01-01T12:00:00Z"))
+	})
+
+	It("is used by wrappers via ContextWithLogger", func() {
+		fn := cron.WrapWithRecover("panic-job", run.Func(func(ctx context.Context) error {
+			panic("banana")
+		}))
+		Expect(fn.Run(cron.ContextWithLogger(ctx, logger))).NotTo(Succeed())
+
+		entries := buffer.Entries("recovered panic")
+		Expect(entries).To(HaveLen(1))
+		Expect(entries[0]).To(HaveKeyWithValue(slog.LevelKey, "WARN"))
+		Expect(entries[0]).To(HaveKeyWithValue(cron.LogKeyJob, "panic-job"))
+		Expect(entries[0]).To(HaveKeyWithValue("panic", "banana"))
+	})
+
+	It("prefers Options.Logger over the logger of the context", func() {
+		contextBuffer := &logBuffer{}
+		ctx = cron.ContextWithLogger(ctx, slog.New(slog.NewJSONHandler(contextBuffer, nil)))
+		b := cron.NewOneTimeCronWithOptions(action, cron.Options{
+			Name:   "logger-job",
+			Logger: logger,
+		})
+		Expect(b.Run(ctx)).To(Succeed())
+
+		Expect(buffer.Entries("action executed")).To(HaveLen(1))
+		Expect(contextBuffer.Entries("action executed")).To(BeEmpty())
+	})
+
+	It("attaches the job name once with WrapWithLogger", func() {
+		fn := cron.WrapWithLogger("logger-job", logger, cron.WrapWithRecover("logger-job", action))
+		Expect(fn.Run(ctx)).To(Succeed())
+
+		Expect(buffer.buffer.String()).To(ContainSubstring(`"job":"logger-job"`))
+		Expect(strings.Count(buffer.buffer.String(), `"job"`)).To(Equal(1))
+	})
+
+	It("writes to glog with NewGlogHandler", func() {
+		glogLogger := slog.New(cron.NewGlogHandler()).With(cron.LogKeyJob, "glog-job").WithGroup("group")
+		Expect(func() {
+			glogLogger.WarnContext(ctx, "glog message", "key", "value", slog.Group("nested", "a", 1))
+			glogLogger.Log(ctx, cron.LevelTrace, "glog trace")
+		}).NotTo(Panic())
+	})
+})
@@ -5,56 +5,24 @@
 package cron
 
 import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/bborbe/errors"
 	"github.com/prometheus/client_golang/prometheus"
 )
 
 var (
-	started = prometheus.NewGaugeVec(prometheus.GaugeOpts{
-		Namespace: "cron",
-		Subsystem: "job",
-		Name:      "started",
-		Help:      "Number of times cron job was started",
-	}, []string{"name"})
-	completed = prometheus.NewGaugeVec(prometheus.GaugeOpts{
-		Namespace: "cron",
-		Subsystem: "job",
-		Name:      "completed",
-		Help:      "Number of times cron job completed successfully",
-	}, []string{"name"})
-	failed = prometheus.NewGaugeVec(prometheus.GaugeOpts{
-		Namespace: "cron",
-		Subsystem: "job",
-		Name:      "failed",
-		Help:      "Number of times cron job failed",
-	}, []string{"name"})
-	lastSuccess = prometheus.NewGaugeVec(prometheus.GaugeOpts{
-		Namespace: "cron",
-		Subsystem: "job",
-		Name:      "last_success",
-		Help:      "Timestamp of last successful run",
-	}, []string{"name"})
-	duration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
-		Namespace: "cron",
-		Subsystem: "job",
-		Name:      "duration_seconds",
-		Help:      "Duration of cron job execution in seconds",
-		Buckets:   prometheus.DefBuckets,
-	}, []string{"name"})
+	defaultMetricsOnce sync.Once
+	defaultMetrics     *metrics
 )
 
-func init() {
-	prometheus.DefaultRegisterer.MustRegister(
-		started,
-		completed,
-		failed,
-		lastSuccess,
-		duration,
-	)
-}
-
-//counterfeiter:generate -o mocks/cron-metrics.go --fake-name CronMetrics . Metrics
+//counterfeiter:generate -o mocks/cron-metrics.go --fake-name CronMetrics . ExtendedMetrics
 
 // Metrics provides methods for collecting and reporting cron job execution statistics.
+// Implementations may also implement the optional metrics interfaces below,
+// e.g. JitterMetrics, to receive the metrics of the corresponding features.
 type Metrics interface {
 	// IncreaseStarted increments the counter for cron job start events.
 	IncreaseStarted(name string)
@@ -68,30 +36,593 @@ type Metrics interface {
 	ObserveDuration(name string, durationSeconds float64)
 }
 
+// JitterMetrics is optionally implemented by Metrics to record the jitter delay.
+type JitterMetrics interface {
+	// SetJitter records the jitter delay in seconds applied before the last execution.
+	SetJitter(name string, delaySeconds float64)
+}
+
+// RetryMetrics is optionally implemented by Metrics to count attempts including retries.
+type RetryMetrics interface {
+	// IncreaseAttempt increments the counter for attempts including retries.
+	IncreaseAttempt(name string)
+	// IncreaseAttemptFailed increments the counter for failed attempts including retries.
+	IncreaseAttemptFailed(name string)
+}
+
+// PanicMetrics is optionally implemented by Metrics to count recovered panics.
+type PanicMetrics interface {
+	// IncreasePanic increments the counter for recovered panics.
+	IncreasePanic(name string)
+}
+
+// OverlapMetrics is optionally implemented by Metrics to count the decisions of the overlap policy.
+type OverlapMetrics interface {
+	// IncreaseSkipped increments the counter for executions skipped due to overlap.
+	IncreaseSkipped(name string)
+	// IncreaseQueued increments the counter for executions queued due to overlap.
+	IncreaseQueued(name string)
+	// IncreaseReplaced increments the counter for executions cancelled due to overlap.
+	IncreaseReplaced(name string)
+}
+
+// MisfireMetrics is optionally implemented by Metrics to record late executions.
+type MisfireMetrics interface {
+	// IncreaseMissed increments the counter for executions skipped because they started too late.
+	IncreaseMissed(name string)
+	// ObserveDelay records the delay between scheduled time and actual start in seconds.
+	ObserveDelay(name string, delaySeconds float64)
+}
+
+// StatusMetrics is optionally implemented by Metrics to record the current status of a job.
+type StatusMetrics interface {
+	// IncreaseRunning increments the gauge of currently running executions.
+	IncreaseRunning(name string)
+	// DecreaseRunning decrements the gauge of currently running executions.
+	DecreaseRunning(name string)
+	// SetNextRun records the timestamp of the next scheduled execution. Zero resets it.
+	SetNextRun(name string, nextRun time.Time)
+	// SetLastFailureToCurrent records the timestamp of the last failed execution.
+	SetLastFailureToCurrent(name string)
+	// SetLastDuration records the duration in seconds of the last execution.
+	SetLastDuration(name string, durationSeconds float64)
+}
+
+// TimeoutMetrics is optionally implemented by Metrics to count timeouts.
+type TimeoutMetrics interface {
+	// IncreaseTimeout increments the counter for executions cancelled by the timeout.
+	IncreaseTimeout(name string)
+}
+
+// LockMetrics is optionally implemented by Metrics to count executions skipped by the lock.
+type LockMetrics interface {
+	// IncreaseLockSkipped increments the counter for executions skipped because the lock was held elsewhere.
+	IncreaseLockSkipped(name string)
+}
+
+// ExtendedMetrics combines Metrics with all optional metrics interfaces.
+// NewMetrics and NewMetricsWithRegisterer return implementations of it.
+type ExtendedMetrics interface {
+	Metrics
+	JitterMetrics
+	RetryMetrics
+	PanicMetrics
+	OverlapMetrics
+	MisfireMetrics
+	StatusMetrics
+	TimeoutMetrics
+	LockMetrics
+}
+
+// nopMetrics discards all metrics. It is used if metrics are not enabled.
+type nopMetrics struct{}
+
+func (nopMetrics) IncreaseStarted(name string)                          {}
+func (nopMetrics) IncreaseFailed(name string)                           {}
+func (nopMetrics) IncreaseCompleted(name string)                        {}
+func (nopMetrics) SetLastSuccessToCurrent(name string)                  {}
+func (nopMetrics) ObserveDuration(name string, durationSeconds float64) {}
+func (nopMetrics) SetJitter(name string, delaySeconds float64)          {}
+func (nopMetrics) IncreaseAttempt(name string)                          {}
+func (nopMetrics) IncreaseAttemptFailed(name string)                    {}
+func (nopMetrics) IncreasePanic(name string)                            {}
+func (nopMetrics) IncreaseSkipped(name string)                          {}
+func (nopMetrics) IncreaseQueued(name string)                           {}
+func (nopMetrics) IncreaseReplaced(name string)                         {}
+func (nopMetrics) IncreaseMissed(name string)                           {}
+func (nopMetrics) ObserveDelay(name string, delaySeconds float64)       {}
+func (nopMetrics) IncreaseRunning(name string)                          {}
+func (nopMetrics) DecreaseRunning(name string)                          {}
+func (nopMetrics) SetNextRun(name string, nextRun time.Time)            {}
+func (nopMetrics) SetLastFailureToCurrent(name string)                  {}
+func (nopMetrics) SetLastDuration(name string, durationSeconds float64) {}
+func (nopMetrics) IncreaseTimeout(name string)                          {}
+func (nopMetrics) IncreaseLockSkipped(name string)                      {}
+
+// extendMetrics returns the metrics as ExtendedMetrics.
+// Methods of optional interfaces the metrics do not implement are ignored.
+func extendMetrics(metrics Metrics) ExtendedMetrics {
+	if extended, ok := metrics.(ExtendedMetrics); ok {
+		return extended
+	}
+	return optionalMetrics{Metrics: metrics}
+}
+
+// optionalMetrics forwards to the optional metrics interfaces the embedded Metrics implements.
+type optionalMetrics struct {
+	Metrics
+}
+
+func (o optionalMetrics) SetJitter(name string, delaySeconds float64) {
+	if m, ok := o.Metrics.(JitterMetrics); ok {
+		m.SetJitter(name, delaySeconds)
+	}
+}
+
+func (o optionalMetrics) IncreaseAttempt(name string) {
+	if m, ok := o.Metrics.(RetryMetrics); ok {
+		m.IncreaseAttempt(name)
+	}
+}
+
+func (o optionalMetrics) IncreaseAttemptFailed(name string) {
+	if m, ok := o.Metrics.(RetryMetrics); ok {
+		m.IncreaseAttemptFailed(name)
+	}
+}
+
+func (o optionalMetrics) IncreasePanic(name string) {
+	if m, ok := o.Metrics.(PanicMetrics); ok {
+		m.IncreasePanic(name)
+	}
+}
+
+func (o optionalMetrics) IncreaseSkipped(name string) {
+	if m, ok := o.Metrics.(OverlapMetrics); ok {
+		m.IncreaseSkipped(name)
+	}
+}
+
+func (o optionalMetrics) IncreaseQueued(name string) {
+	if m, ok := o.Metrics.(OverlapMetrics); ok {
+		m.IncreaseQueued(name)
+	}
+}
+
+func (o optionalMetrics) IncreaseReplaced(name string) {
+	if m, ok := o.Metrics.(OverlapMetrics); ok {
+		m.IncreaseReplaced(name)
+	}
+}
+
+func (o optionalMetrics) IncreaseMissed(name string) {
+	if m, ok := o.Metrics.(MisfireMetrics); ok {
+		m.IncreaseMissed(name)
+	}
+}
+
+func (o optionalMetrics) ObserveDelay(name string, delaySeconds float64) {
+	if m, ok := o.Metrics.(MisfireMetrics); ok {
+		m.ObserveDelay(name, delaySeconds)
+	}
+}
+
+func (o optionalMetrics) IncreaseRunning(name string) {
+	if m, ok := o.Metrics.(StatusMetrics); ok {
+		m.IncreaseRunning(name)
+	}
+}
+
+func (o optionalMetrics) DecreaseRunning(name string) {
+	if m, ok := o.Metrics.(StatusMetrics); ok {
+		m.DecreaseRunning(name)
+	}
+}
+
+func (o optionalMetrics) SetNextRun(name string, nextRun time.Time) {
+	if m, ok := o.Metrics.(StatusMetrics); ok {
+		m.SetNextRun(name, nextRun)
+	}
+}
+
+func (o optionalMetrics) SetLastFailureToCurrent(name string) {
+	if m, ok := o.Metrics.(StatusMetrics); ok {
+		m.SetLastFailureToCurrent(name)
+	}
+}
+
+func (o optionalMetrics) SetLastDuration(name string, durationSeconds float64) {
+	if m, ok := o.Metrics.(StatusMetrics); ok {
+		m.SetLastDuration(name, durationSeconds)
+	}
+}
+
+func (o optionalMetrics) IncreaseTimeout(name string) {
+	if m, ok := o.Metrics.(TimeoutMetrics); ok {
+		m.IncreaseTimeout(name)
+	}
+}
+
+func (o optionalMetrics) IncreaseLockSkipped(name string) {
+	if m, ok := o.Metrics.(LockMetrics); ok {
+		m.IncreaseLockSkipped(name)
+	}
+}
+
+// MetricsOptions configures the metrics created by NewMetricsWithRegisterer.
+type MetricsOptions struct {
+	// Buckets apply to the duration and delay histograms. Empty uses prometheus.DefBuckets.
+	Buckets []float64
+	// LegacyGauges reports <namespace>_job_started, <namespace>_job_completed and
+	// <namespace>_job_failed as gauges like earlier versions did, instead of the
+	// *_total counters, to keep existing dashboards working.
+	LegacyGauges bool
+}
+
 // NewMetrics creates a new Metrics instance that reports to Prometheus.
+// All instances share the cron_job_* metrics registered on prometheus.DefaultRegisterer
+// by the first call, importing the package registers nothing. Equal metrics registered
+// before, e.g. by another copy of the package, are reused. If registration fails,
+// a warning is logged and the metrics are still collected but not exported.
 func NewMetrics() Metrics {
-	return &metrics{}
+	defaultMetricsOnce.Do(func() {
+		defaultMetrics = newMetrics("cron", nil, prometheus.DefBuckets, false)
+		if err := defaultMetrics.register(prometheus.DefaultRegisterer); err != nil {
+			defaultLogger.Warn("register cron metrics failed", LogKeyError, err)
+		}
+	})
+	return defaultMetrics
+}
+
+// NewMetricsWithRegisterer creates a Metrics instance registered on the given registerer,
+// e.g. an isolated prometheus.NewRegistry(). Metrics are named <namespace>_job_*, an empty
+// namespace uses "cron". The const labels, e.g. team or tenant, are added to every metric.
+// The metrics options set histogram buckets and legacy gauges.
+// Metrics already registered with the same definition are reused, so calling it twice is safe.
+func NewMetricsWithRegisterer(
+	ctx context.Context,
+	registerer prometheus.Registerer,
+	namespace string,
+	constLabels prometheus.Labels,
+	metricsOptions MetricsOptions,
+) (ExtendedMetrics, error) {
+	if namespace == "" {
+		namespace = "cron"
+	}
+	buckets := metricsOptions.Buckets
+	if len(buckets) == 0 {
+		buckets = prometheus.DefBuckets
+	}
+	m := newMetrics(namespace, constLabels, buckets, metricsOptions.LegacyGauges)
+	if err := m.register(registerer); err != nil {
+		return nil, errors.Wrap(ctx, err, "register metrics failed")
+	}
+	return m, nil
+}
+
+// register registers all metrics on the registerer and reuses equal metrics already registered.
+func (c *metrics) register(registerer prometheus.Registerer) error {
+	for _, err := range []error{
+		register(registerer, &c.started),
+		register(registerer, &c.completed),
+		register(registerer, &c.failed),
+		register(registerer, &c.lastSuccess),
+		register(registerer, &c.jitter),
+		register(registerer, &c.attempts),
+		register(registerer, &c.attemptsFailed),
+		register(registerer, &c.panics),
+		register(registerer, &c.skipped),
+		register(registerer, &c.queued),
+		register(registerer, &c.replaced),
+		register(registerer, &c.missed),
+		register(registerer, &c.delay),
+		register(registerer, &c.duration),
+		register(registerer, &c.running),
+		register(registerer, &c.nextRun),
+		register(registerer, &c.lastFailure),
+		register(registerer, &c.lastDuration),
+		register(registerer, &c.timeouts),
+		register(registerer, &c.lockSkipped),
+	} {
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// register registers the collector. If an equal collector is already registered,
+// it replaces the given one with the existing collector.
+func register[C prometheus.Collector](registerer prometheus.Registerer, collector *C) error {
+	err := registerer.Register(*collector)
+	if alreadyRegistered, ok := err.(prometheus.AlreadyRegisteredError); ok {
+		if existing, ok := alreadyRegistered.ExistingCollector.(C); ok {
+			*collector = existing
+			return nil
+		}
+	}
+	return err
+}
+
+func newMetrics(
+	namespace string,
+	constLabels prometheus.Labels,
+	buckets []float64,
+	legacyGauges bool,
+) *metrics {
+	return &metrics{
+		started: newCounterVec(legacyGauges, prometheus.Opts{
+			Namespace:   namespace,
+			Subsystem:   "job",
+			Name:        "started",
+			Help:        "Number of times cron job was started",
+			ConstLabels: constLabels,
+		}),
+		completed: newCounterVec(legacyGauges, prometheus.Opts{
+			Namespace:   namespace,
+			Subsystem:   "job",
+			Name:        "completed",
+			Help:        "Number of times cron job completed successfully",
+			ConstLabels: constLabels,
+		}),
+		failed: newCounterVec(legacyGauges, prometheus.Opts{
+			Namespace:   namespace,
+			Subsystem:   "job",
+			Name:        "failed",
+			Help:        "Number of times cron job failed",
+			ConstLabels: constLabels,
+		}),
+		lastSuccess: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace:   namespace,
+			Subsystem:   "job",
+			Name:        "last_success",
+			Help:        "Timestamp of last successful run",
+			ConstLabels: constLabels,
+		}, []string{"name"}),
+		jitter: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace:   namespace,
+			Subsystem:   "job",
+			Name:        "jitter_seconds",
+			Help:        "Jitter delay applied before the last execution in seconds",
+			ConstLabels: constLabels,
+		}, []string{"name"}),
+		attempts: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace:   namespace,
+			Subsystem:   "job",
+			Name:        "attempts_total",
+			Help:        "Number of attempts including retries",
+			ConstLabels: constLabels,
+		}, []string{"name"}),
+		attemptsFailed: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace:   namespace,
+			Subsystem:   "job",
+			Name:        "attempts_failed_total",
+			Help:        "Number of failed attempts including retries",
+			ConstLabels: constLabels,
+		}, []string{"name"}),
+		panics: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace:   namespace,
+			Subsystem:   "job",
+			Name:        "panics_total",
+			Help:        "Number of times cron job panicked",
+			ConstLabels: constLabels,
+		}, []string{"name"}),
+		skipped: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace:   namespace,
+			Subsystem:   "job",
+			Name:        "skipped_total",
+			Help:        "Number of executions skipped because the previous one was still running",
+			ConstLabels: constLabels,
+		}, []string{"name"}),
+		queued: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace:   namespace,
+			Subsystem:   "job",
+			Name:        "queued_total",
+			Help:        "Number of executions queued behind the running one",
+			ConstLabels: constLabels,
+		}, []string{"name"}),
+		replaced: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace:   namespace,
+			Subsystem:   "job",
+			Name:        "replaced_total",
+			Help:        "Number of running executions cancelled in favor of a new one",
+			ConstLabels: constLabels,
+		}, []string{"name"}),
+		missed: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace:   namespace,
+			Subsystem:   "job",
+			Name:        "missed_total",
+			Help:        "Number of executions skipped because they started later than the misfire threshold",
+			ConstLabels: constLabels,
+		}, []string{"name"}),
+		delay: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace:   namespace,
+			Subsystem:   "job",
+			Name:        "delay_seconds",
+			Help:        "Delay between scheduled time and actual start of executions in seconds",
+			ConstLabels: constLabels,
+			Buckets:     buckets,
+		}, []string{"name"}),
+		duration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace:   namespace,
+			Subsystem:   "job",
+			Name:        "duration_seconds",
+			Help:        "Duration of cron job execution in seconds",
+			ConstLabels: constLabels,
+			Buckets:     buckets,
+		}, []string{"name"}),
+		running: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace:   namespace,
+			Subsystem:   "job",
+			Name:        "running",
+			Help:        "Number of executions currently running",
+			ConstLabels: constLabels,
+		}, []string{"name"}),
+		nextRun: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace:   namespace,
+			Subsystem:   "job",
+			Name:        "next_run",
+			Help:        "Timestamp of the next scheduled execution",
+			ConstLabels: constLabels,
+		}, []string{"name"}),
+		lastFailure: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace:   namespace,
+			Subsystem:   "job",
+			Name:        "last_failure",
+			Help:        "Timestamp of last failed run",
+			ConstLabels: constLabels,
+		}, []string{"name"}),
+		lastDuration: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace:   namespace,
+			Subsystem:   "job",
+			Name:        "last_duration_seconds",
+			Help:        "Duration of the last execution in seconds",
+			ConstLabels: constLabels,
+		}, []string{"name"}),
+		timeouts: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace:   namespace,
+			Subsystem:   "job",
+			Name:        "timeouts_total",
+			Help:        "Number of executions cancelled by the timeout",
+			ConstLabels: constLabels,
+		}, []string{"name"}),
+		lockSkipped: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace:   namespace,
+			Subsystem:   "job",
+			Name:        "lock_skipped_total",
+			Help:        "Number of executions skipped because the lock was held by another replica",
+			ConstLabels: constLabels,
+		}, []string{"name"}),
+	}
+}
+
+// newCounterVec returns a counter vector named *_total, or a gauge vector without suffix with legacyGauges.
+func newCounterVec(legacyGauges bool, opts prometheus.Opts) prometheus.Collector {
+	if legacyGauges {
+		return prometheus.NewGaugeVec(prometheus.GaugeOpts(opts), []string{"name"})
+	}
+	opts.Name += "_total"
+	return prometheus.NewCounterVec(prometheus.CounterOpts(opts), []string{"name"})
 }
 
 type metrics struct {
+	// started, completed and failed are *prometheus.CounterVec, or *prometheus.GaugeVec with MetricsOptions.LegacyGauges.
+	started        prometheus.Collector
+	completed      prometheus.Collector
+	failed         prometheus.Collector
+	lastSuccess    *prometheus.GaugeVec
+	jitter         *prometheus.GaugeVec
+	attempts       *prometheus.CounterVec
+	attemptsFailed *prometheus.CounterVec
+	panics         *prometheus.CounterVec
+	skipped        *prometheus.CounterVec
+	queued         *prometheus.CounterVec
+	replaced       *prometheus.CounterVec
+	missed         *prometheus.CounterVec
+	delay          *prometheus.HistogramVec
+	duration       *prometheus.HistogramVec
+	running        *prometheus.GaugeVec
+	nextRun        *prometheus.GaugeVec
+	lastFailure    *prometheus.GaugeVec
+	lastDuration   *prometheus.GaugeVec
+	timeouts       *prometheus.CounterVec
+	lockSkipped    *prometheus.CounterVec
+}
+
+// inc increments the counter or legacy gauge vector for the given name.
+func inc(collector prometheus.Collector, name string) {
+	switch vec := collector.(type) {
+	case *prometheus.CounterVec:
+		vec.With(prometheus.Labels{"name": name}).Inc()
+	case *prometheus.GaugeVec:
+		vec.With(prometheus.Labels{"name": name}).Inc()
+	}
 }
 
 func (c *metrics) IncreaseStarted(name string) {
-	started.With(prometheus.Labels{"name": name}).Inc()
+	inc(c.started, name)
 }
 
 func (c *metrics) IncreaseFailed(name string) {
-	failed.With(prometheus.Labels{"name": name}).Inc()
+	inc(c.failed, name)
 }
 
 func (c *metrics) IncreaseCompleted(name string) {
-	completed.With(prometheus.Labels{"name": name}).Inc()
+	inc(c.completed, name)
 }
 
 func (c *metrics) SetLastSuccessToCurrent(name string) {
-	lastSuccess.With(prometheus.Labels{"name": name}).SetToCurrentTime()
+	c.lastSuccess.With(prometheus.Labels{"name": name}).SetToCurrentTime()
 }
 
 func (c *metrics) ObserveDuration(name string, durationSeconds float64) {
-	duration.With(prometheus.Labels{"name": name}).Observe(durationSeconds)
+	c.duration.With(prometheus.Labels{"name": name}).Observe(durationSeconds)
+}
+
+func (c *metrics) SetJitter(name string, delaySeconds float64) {
+	c.jitter.With(prometheus.Labels{"name": name}).Set(delaySeconds)
+}
+
+func (c *metrics) IncreaseAttempt(name string) {
+	c.attempts.With(prometheus.Labels{"name": name}).Inc()
+}
+
+func (c *metrics) IncreaseAttemptFailed(name string) {
+	c.attemptsFailed.With(prometheus.Labels{"name": name}).Inc()
+}
+
+func (c *metrics) IncreasePanic(name string) {
+	c.panics.With(prometheus.Labels{"name": name}).Inc()
+}
+
+func (c *metrics) IncreaseSkipped(name string) {
+	c.skipped.With(prometheus.Labels{"name": name}).Inc()
+}
+
+func (c *metrics) IncreaseQueued(name string) {
+	c.queued.With(prometheus.Labels{"name": name}).Inc()
+}
+
+func (c *metrics) IncreaseReplaced(name string) {
+	c.replaced.With(prometheus.Labels{"name": name}).Inc()
+}
+
+func (c *metrics) IncreaseMissed(name string) {
+	c.missed.With(prometheus.Labels{"name": name}).Inc()
+}
+
+func (c *metrics) ObserveDelay(name string, delaySeconds float64) {
+	c.delay.With(prometheus.Labels{"name": name}).Observe(delaySeconds)
+}
+
+func (c *metrics) IncreaseRunning(name string) {
+	c.running.With(prometheus.Labels{"name": name}).Inc()
+}
+
+func (c *metrics) DecreaseRunning(name string) {
+	c.running.With(prometheus.Labels{"name": name}).Dec()
+}
+
+func (c *metrics) SetNextRun(name string, nextRun time.Time) {
+	if nextRun.IsZero() {
+		c.nextRun.With(prometheus.Labels{"name": name}).Set(0)
+		return
+	}
+	c.nextRun.With(prometheus.Labels{"name": name}).Set(float64(nextRun.Unix()))
+}
+
+func (c *metrics) SetLastFailureToCurrent(name string) {
+	c.lastFailure.With(prometheus.Labels{"name": name}).SetToCurrentTime()
+}
+
+func (c *metrics) SetLastDuration(name string, durationSeconds float64) {
+	c.lastDuration.With(prometheus.Labels{"name": name}).Set(durationSeconds)
+}
+
+func (c *metrics) IncreaseTimeout(name string) {
+	c.timeouts.With(prometheus.Labels{"name": name}).Inc()
+}
+
+func (c *metrics) IncreaseLockSkipped(name string) {
+	c.lockSkipped.With(prometheus.Labels{"name": name}).Inc()
 }
@@ -5,17 +5,26 @@
 package cron_test
 
 import (
+	"context"
+	"sync/atomic"
+	"time"
+
+	"github.com/bborbe/run"
+	libtime "github.com/bborbe/time"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus"
 
 	"github.com/bborbe/cron"
 )
 
 var _ = Describe("CronMetrics", func() {
-	var metrics cron.Metrics
+	var metrics cron.ExtendedMetrics
 
 	BeforeEach(func() {
-		metrics = cron.NewMetrics()
+		var ok bool
+		metrics, ok = cron.NewMetrics().(cron.ExtendedMetrics)
+		Expect(ok).To(BeTrue())
 	})
 
 	Describe("NewCronMetrics", func() {
@@ -113,6 +122,266 @@ var _ = Describe("CronMetrics", func() {
 		})
 	})
 
+	Describe("SetJitter", func() {
+		It("does not panic when called", func() {
+			Expect(func() {
+				metrics.SetJitter("test-job", 12.5)
+			}).NotTo(Panic())
+		})
+
+		It("reports the value", func() {
+			metrics.SetJitter("jitter-metrics-job", 7)
+			Expect(gaugeValue("cron_job_jitter_seconds", "jitter-metrics-job")).To(Equal(7.0))
+		})
+	})
+
+	Describe("IncreaseAttempt", func() {
+		It("does not panic when called", func() {
+			Expect(func() {
+				metrics.IncreaseAttempt("test-job")
+			}).NotTo(Panic())
+		})
+	})
+
+	Describe("IncreaseAttemptFailed", func() {
+		It("does not panic when called", func() {
+			Expect(func() {
+				metrics.IncreaseAttemptFailed("test-job")
+			}).NotTo(Panic())
+		})
+	})
+
+	Describe("IncreasePanic", func() {
+		It("does not panic when called", func() {
+			Expect(func() {
+				metrics.IncreasePanic("test-job")
+			}).NotTo(Panic())
+		})
+	})
+
+	Describe("IncreaseSkipped", func() {
+		It("does not panic when called", func() {
+			Expect(func() {
+				metrics.IncreaseSkipped("test-job")
+			}).NotTo(Panic())
+		})
+	})
+
+	Describe("IncreaseQueued", func() {
+		It("does not panic when called", func() {
+			Expect(func() {
+				metrics.IncreaseQueued("test-job")
+			}).NotTo(Panic())
+		})
+	})
+
+	Describe("IncreaseReplaced", func() {
+		It("does not panic when called", func() {
+			Expect(func() {
+				metrics.IncreaseReplaced("test-job")
+			}).NotTo(Panic())
+		})
+	})
+
+	Describe("IncreaseMissed", func() {
+		It("does not panic when called", func() {
+			Expect(func() {
+				metrics.IncreaseMissed("test-job")
+			}).NotTo(Panic())
+		})
+	})
+
+	Describe("IncreaseLockSkipped", func() {
+		It("does not panic when called", func() {
+			Expect(func() {
+				metrics.IncreaseLockSkipped("test-job")
+			}).NotTo(Panic())
+		})
+	})
+
+	Describe("ObserveDelay", func() {
+		It("does not panic when called", func() {
+			Expect(func() {
+				metrics.ObserveDelay("test-job", 0.25)
+			}).NotTo(Panic())
+		})
+	})
+
+	Describe("NewMetricsWithRegisterer", func() {
+		var ctx context.Context
+		var registry *prometheus.Registry
+
+		BeforeEach(func() {
+			ctx = context.Background()
+			registry = prometheus.NewRegistry()
+		})
+
+		It("reports to the given registry with namespace and const labels", func() {
+			metrics, err := cron.NewMetricsWithRegisterer(
+				ctx,
+				registry,
+				"myapp",
+				prometheus.Labels{"team": "platform"},
+				cron.MetricsOptions{},
+			)
+			Expect(err).To(BeNil())
+			metrics.IncreaseAttempt("test-job")
+
+			families, err := registry.Gather()
+			Expect(err).To(BeNil())
+			var found bool
+			for _, family := range families {
+				if family.GetName() != "myapp_job_attempts_total" {
+					continue
+				}
+				Expect(family.GetMetric()).To(HaveLen(1))
+				labels := map[string]string{}
+				for _, label := range family.GetMetric()[0].GetLabel() {
+					labels[label.GetName()] = label.GetValue()
+				}
+				Expect(labels).To(Equal(map[string]string{"name": "test-job", "team": "platform"}))
+				Expect(family.GetMetric()[0].GetCounter().GetValue()).To(Equal(1.0))
+				found = true
+			}
+			Expect(found).To(BeTrue())
+		})
+
+		It("uses the given buckets", func() {
+			metrics, err := cron.NewMetricsWithRegisterer(ctx, registry, "", nil, cron.MetricsOptions{Buckets: []float64{1, 10}})
+			Expect(err).To(BeNil())
+			metrics.ObserveDuration("test-job", 5)
+
+			families, err := registry.Gather()
+			Expect(err).To(BeNil())
+			var found bool
+			for _, family := range families {
+				if family.GetName() != "cron_job_duration_seconds" {
+					continue
+				}
+				Expect(family.GetMetric()[0].GetHistogram().GetBucket()).To(HaveLen(2))
+				found = true
+			}
+			Expect(found).To(BeTrue())
+		})
+
+		It("reuses metrics registered before", func() {
+			first, err := cron.NewMetricsWithRegisterer(ctx, registry, "myapp", nil, cron.MetricsOptions{})
+			Expect(err).To(BeNil())
+			second, err := cron.NewMetricsWithRegisterer(ctx, registry, "myapp", nil, cron.MetricsOptions{})
+			Expect(err).To(BeNil())
+			first.IncreasePanic("test-job")
+			second.IncreasePanic("test-job")
+
+			families, err := registry.Gather()
+			Expect(err).To(BeNil())
+			for _, family := range families {
+				if family.GetName() == "myapp_job_panics_total" {
+					Expect(family.GetMetric()[0].GetCounter().GetValue()).To(Equal(2.0))
+				}
+			}
+		})
+
+		// metricType returns the type of the given metric in the registry.
+		metricType := func(metricName string) string {
+			families, err := registry.Gather()
+			Expect(err).To(BeNil())
+			for _, family := range families {
+				if family.GetName() == metricName {
+					return family.GetType().String()
+				}
+			}
+			return ""
+		}
+
+		It("reports started, completed and failed as counters", func() {
+			metrics, err := cron.NewMetricsWithRegisterer(ctx, registry, "", nil, cron.MetricsOptions{})
+			Expect(err).To(BeNil())
+			metrics.IncreaseStarted("test-job")
+			metrics.IncreaseCompleted("test-job")
+			metrics.IncreaseFailed("test-job")
+			Expect(metricType("cron_job_started_total")).To(Equal("COUNTER"))
+			Expect(metricType("cron_job_completed_total")).To(Equal("COUNTER"))
+			Expect(metricType("cron_job_failed_total")).To(Equal("COUNTER"))
+		})
+
+		It("reports started, completed and failed as gauges with LegacyGauges", func() {
+			metrics, err := cron.NewMetricsWithRegisterer(ctx, registry, "", nil, cron.MetricsOptions{LegacyGauges: true})
+			Expect(err).To(BeNil())
+			metrics.IncreaseStarted("test-job")
+			metrics.IncreaseCompleted("test-job")
+			metrics.IncreaseFailed("test-job")
+			Expect(metricType("cron_job_started")).To(Equal("GAUGE"))
+			Expect(metricType("cron_job_completed")).To(Equal("GAUGE"))
+			Expect(metricType("cron_job_failed")).To(Equal("GAUGE"))
+		})
+
+		It("reports the next run as timestamp", func() {
+			metrics, err := cron.NewMetricsWithRegisterer(ctx, registry, "", nil, cron.MetricsOptions{})
+			Expect(err).To(BeNil())
+			metrics.SetNextRun("test-job", time.Unix(1767268800, 0))
+
+			families, err := registry.Gather()
+			Expect(err).To(BeNil())
+			var found bool
+			for _, family := range families {
+				if family.GetName() == "cron_job_next_run" {
+					Expect(family.GetMetric()[0].GetGauge().GetValue()).To(Equal(1767268800.0))
+					found = true
+				}
+			}
+			Expect(found).To(BeTrue())
+		})
+
+		It("returns an error on conflicting metrics", func() {
+			_, err := cron.NewMetricsWithRegisterer(ctx, registry, "myapp", prometheus.Labels{"team": "a"}, cron.MetricsOptions{})
+			Expect(err).To(BeNil())
+			_, err = cron.NewMetricsWithRegisterer(ctx, registry, "myapp", prometheus.Labels{"tenant": "b"}, cron.MetricsOptions{})
+			Expect(err).NotTo(BeNil())
+		})
+	})
+
+	Describe("IncreaseRunning and DecreaseRunning", func() {
+		It("does not panic when called", func() {
+			Expect(func() {
+				metrics.IncreaseRunning("test-job")
+				metrics.DecreaseRunning("test-job")
+			}).NotTo(Panic())
+		})
+	})
+
+	Describe("SetNextRun", func() {
+		It("does not panic when called", func() {
+			Expect(func() {
+				metrics.SetNextRun("test-job", time.Now())
+				metrics.SetNextRun("test-job", time.Time{})
+			}).NotTo(Panic())
+		})
+	})
+
+	Describe("SetLastFailureToCurrent", func() {
+		It("does not panic when called", func() {
+			Expect(func() {
+				metrics.SetLastFailureToCurrent("test-job")
+			}).NotTo(Panic())
+		})
+	})
+
+	Describe("SetLastDuration", func() {
+		It("does not panic when called", func() {
+			Expect(func() {
+				metrics.SetLastDuration("test-job", 1.5)
+			}).NotTo(Panic())
+		})
+	})
+
+	Describe("IncreaseTimeout", func() {
+		It("does not panic when called", func() {
+			Expect(func() {
+				metrics.IncreaseTimeout("test-job")
+			}).NotTo(Panic())
+		})
+	})
+
 	Describe("Typical usage patterns", func() {
 		It("supports typical success flow", func() {
 			Expect(func() {
@@ -142,4 +411,44 @@ var _ = Describe("CronMetrics", func() {
 			}).NotTo(Panic())
 		})
 	})
+
+	Describe("Metrics without optional interfaces", func() {
+		It("is used by all wrappers", func() {
+			baseMetrics := &countingMetrics{}
+			fn := cron.WrapWithOptions(run.Func(func(ctx context.Context) error {
+				panic("banana")
+			}), cron.Options{
+				Name:          "base-metrics-job",
+				EnableMetrics: true,
+				Metrics:       baseMetrics,
+				RecoverPanics: true,
+				Timeout:       libtime.Minute,
+				Retry:         cron.RetryOptions{MaxAttempts: 2},
+				OverlapPolicy: cron.OverlapPolicySkip,
+			})
+			Expect(fn.Run(context.Background())).NotTo(Succeed())
+			Expect(baseMetrics.started.Load()).To(Equal(int64(1)))
+			Expect(baseMetrics.failed.Load()).To(Equal(int64(1)))
+		})
+	})
 })
+
+// countingMetrics implements only Metrics without the optional interfaces.
+type countingMetrics struct {
+	started atomic.Int64
+	failed  atomic.Int64
+}
+
+func (c *countingMetrics) IncreaseStarted(name string) {
+	c.started.Add(1)
+}
+
+func (c *countingMetrics) IncreaseFailed(name string) {
+	c.failed.Add(1)
+}
+
+func (c *countingMetrics) IncreaseCompleted(name string) {}
+
+func (c *countingMetrics) SetLastSuccessToCurrent(name string) {}
+
+func (c *countingMetrics) ObserveDuration(name string, durationSeconds float64) {}
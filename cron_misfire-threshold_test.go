@@ -0,0 +1,130 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package cron_test
+
+import (
+	"context"
+	"time"
+
+	"github.com/bborbe/run"
+	libtime "github.com/bborbe/time"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/bborbe/cron"
+)
+
+var _ = Describe("MisfireThreshold", func() {
+	var ctx context.Context
+	var cancel context.CancelFunc
+	var clock *cron.FakeClock
+	var options cron.Options
+	var history cron.History
+	var action run.Runnable
+
+	// skipped returns the skipped flag of all executions so far.
+	skipped := func() []bool {
+		var result []bool
+		for _, execution := range history.Executions() {
+			result = append(result, execution.Skipped)
+		}
+		return result
+	}
+
+	BeforeEach(func() {
+		ctx, cancel = context.WithCancel(context.Background())
+		clock = cron.NewFakeClock(time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC))
+		history = cron.NewHistory(10)
+		options = cron.Options{
+			Name:             "misfire-threshold-job",
+			EnableMetrics:    true,
+			MisfireThreshold: libtime.Minute,
+			History:          history,
+			Clock:            clock,
+		}
+		action = run.Func(func(ctx context.Context) error {
+			return nil
+		})
+	})
+	AfterEach(func() {
+		cancel()
+	})
+
+	Context("interval", func() {
+		BeforeEach(func() {
+			b := cron.NewIntervalCronWithOptions(libtime.Hour, action, options)
+			go func() {
+				_ = b.Run(ctx)
+			}()
+			Eventually(skipped).Should(Equal([]bool{false}))
+			Expect(clock.WaitForTimers(ctx, 1)).To(Succeed())
+		})
+		It("runs executions within the threshold", func() {
+			clock.Add(time.Hour + 30*time.Second)
+			Eventually(skipped).Should(Equal([]bool{false, false}))
+		})
+		It("skips executions later than the threshold", func() {
+			clock.Add(2 * time.Hour)
+			Eventually(skipped).Should(Equal([]bool{false, true}))
+			Expect(counterValue("cron_job_missed_total", "misfire-threshold-job")).To(BeNumerically(">=", 1))
+		})
+	})
+
+	Context("expression", func() {
+		BeforeEach(func() {
+			b := cron.NewExpressionCronWithOptions("0 0 * * * ?", action, options)
+			go func() {
+				_ = b.Run(ctx)
+			}()
+			Expect(clock.WaitForTimers(ctx, 1)).To(Succeed())
+		})
+		It("runs executions within the threshold", func() {
+			clock.Add(time.Hour + 30*time.Second)
+			Eventually(skipped).Should(Equal([]bool{false}))
+		})
+		It("skips executions later than the threshold", func() {
+			clock.Add(2 * time.Hour)
+			Eventually(skipped).Should(Equal([]bool{true}))
+		})
+	})
+
+	It("skips queued executions starting later than the threshold", func() {
+		options.OverlapPolicy = cron.OverlapPolicyQueue
+		options.MisfireThreshold = 30 * libtime.Second
+		started := make(chan struct{}, 2)
+		release := make(chan struct{})
+		action = run.Func(func(ctx context.Context) error {
+			started <- struct{}{}
+			<-release
+			return nil
+		})
+		b := cron.NewExpressionCronWithOptions("0 * * * * ?", action, options)
+		go func() {
+			_ = b.Run(ctx)
+		}()
+		Expect(clock.WaitForTimers(ctx, 1)).To(Succeed())
+		clock.Add(time.Minute)
+		Eventually(started).Should(Receive())
+		Expect(clock.WaitForTimers(ctx, 1)).To(Succeed())
+		clock.Add(time.Minute)
+		Expect(clock.WaitForTimers(ctx, 1)).To(Succeed())
+		clock.Add(45 * time.Second)
+		close(release)
+		Eventually(skipped).Should(Equal([]bool{false, true}))
+		Consistently(started).ShouldNot(Receive())
+	})
+	It("does not skip catch-up executions", func() {
+		clock.Add(30 * time.Minute)
+		stateStore := cron.NewMemoryStateStore()
+		Expect(stateStore.SetLastSuccess(ctx, "misfire-threshold-job", clock.Now().Add(-2*time.Hour))).To(Succeed())
+		options.StateStore = stateStore
+		options.MisfirePolicy = cron.MisfirePolicyRunOnce
+		b := cron.NewExpressionCronWithOptions("0 0 * * * ?", action, options)
+		go func() {
+			_ = b.Run(ctx)
+		}()
+		Eventually(skipped).Should(Equal([]bool{false}))
+	})
+})
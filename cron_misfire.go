@@ -0,0 +1,150 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package cron
+
+import (
+	"context"
+	"sync/atomic"
+	"time"
+
+	"github.com/bborbe/run"
+	"github.com/robfig/cron/v3"
+)
+
+// DefaultMaxMisfires is the number of executions caught up with MisfirePolicyRunAll
+// if Options.MaxMisfires is not set.
+const DefaultMaxMisfires = 100
+
+// MisfirePolicy decides how an expression cron catches up on executions
+// it missed while the process was down. It requires Options.StateStore.
+type MisfirePolicy string
+
+const (
+	// MisfirePolicySkip ignores missed executions. This is the default.
+	MisfirePolicySkip MisfirePolicy = "skip"
+	// MisfirePolicyRunOnce runs a single execution for the newest missed scheduled time on startup
+	// and records it as last success, so older missed times are dropped.
+	MisfirePolicyRunOnce MisfirePolicy = "run-once"
+	// MisfirePolicyRunAll runs the missed executions on startup, oldest first,
+	// limited to the oldest Options.MaxMisfires.
+	MisfirePolicyRunAll MisfirePolicy = "run-all"
+)
+
+// String returns the misfire policy as a string.
+func (m MisfirePolicy) String() string {
+	return string(m)
+}
+
+// wrapWithMisfireThreshold measures how late a scheduled execution starts and skips it
+// if the delay exceeds options.MisfireThreshold. WrapWithOptions applies it inside the
+// overlap policy, so the time waiting in the queue counts as late. The intended jitter
+// delay does not. Executions without scheduled time, e.g. one-time crons and manual
+// triggers, and catch-up executions, which are late on purpose, bypass the threshold.
+func wrapWithMisfireThreshold(options Options, clock Clock, fn run.Runnable) run.Runnable {
+	metrics := options.enabledMetrics()
+	return run.Func(func(ctx context.Context) error {
+		scheduledTime := scheduledTimeFromContext(ctx)
+		if scheduledTime.IsZero() || isCatchUp(ctx) {
+			return fn.Run(ctx)
+		}
+		delay := max(clock.Now().Sub(scheduledTime)-jitterFromContext(ctx), 0)
+		metrics.ObserveDelay(options.Name, delay.Seconds())
+		if options.MisfireThreshold > 0 && delay > options.MisfireThreshold.Duration() {
+			jobLogger(ctx, options.Name).WarnContext(
+				ctx,
+				"cron started too late, skip execution",
+				LogKeyScheduledTime, scheduledTime,
+				"delay", delay,
+			)
+			metrics.IncreaseMissed(options.Name)
+			recordSkipped(ctx, options.History, clock)
+			options.listenerOrDefault().OnSkip(ctx, currentExecution(ctx, options.Name, clock), SkipReasonMisfire)
+			return nil
+		}
+		return fn.Run(ctx)
+	})
+}
+
+// withCatchUp marks an execution as catch-up of a missed scheduled time.
+func withCatchUp(ctx context.Context) context.Context {
+	return context.WithValue(ctx, catchUpContextKey, true)
+}
+
+func isCatchUp(ctx context.Context) bool {
+	catchUp, _ := ctx.Value(catchUpContextKey).(bool)
+	return catchUp
+}
+
+// wrapWithLastSuccess records the scheduled time of successful scheduled executions in the state store.
+// Manual executions without a scheduled time and skipped executions are not recorded, neither are
+// scheduled times older than the recorded one, e.g. of overlapping executions finishing out of order.
+func wrapWithLastSuccess(options Options, fn run.Runnable) run.Runnable {
+	if options.StateStore == nil {
+		return fn
+	}
+	return run.Func(func(ctx context.Context) error {
+		skipped := &atomic.Bool{}
+		if err := fn.Run(context.WithValue(ctx, skippedContextKey, skipped)); err != nil {
+			return err
+		}
+		scheduledTime := scheduledTimeFromContext(ctx)
+		if scheduledTime.IsZero() || skipped.Load() {
+			return nil
+		}
+		lastSuccess, err := options.StateStore.LastSuccess(ctx, options.Name)
+		if err != nil {
+			jobLogger(ctx, options.Name).WarnContext(ctx, "read last success failed", LogKeyError, err)
+			return nil
+		}
+		if !scheduledTime.After(lastSuccess) {
+			return nil
+		}
+		if err := options.StateStore.SetLastSuccess(ctx, options.Name, scheduledTime); err != nil {
+			jobLogger(ctx, options.Name).WarnContext(ctx, "record last success failed", LogKeyError, err)
+		}
+		return nil
+	})
+}
+
+// missedTimes returns the scheduled times in (lastSuccess, now] the misfire policy wants to catch up on:
+// the newest one for MisfirePolicyRunOnce and the oldest ones up to the limit for MisfirePolicyRunAll.
+// MisfirePolicyRunAll stops at the limit, so a long downtime of a frequent schedule does not walk every missed time.
+func missedTimes(
+	schedule cron.Schedule,
+	misfirePolicy MisfirePolicy,
+	maxMisfires int,
+	lastSuccess time.Time,
+	now time.Time,
+) []time.Time {
+	if lastSuccess.IsZero() {
+		return nil
+	}
+	switch misfirePolicy {
+	case MisfirePolicyRunOnce:
+		var newest time.Time
+		for next := schedule.Next(lastSuccess); !next.IsZero() && !next.After(now); next = schedule.Next(next) {
+			newest = next
+		}
+		if newest.IsZero() {
+			return nil
+		}
+		return []time.Time{newest}
+	case MisfirePolicyRunAll:
+		limit := maxMisfires
+		if limit <= 0 {
+			limit = DefaultMaxMisfires
+		}
+		var result []time.Time
+		for next := schedule.Next(lastSuccess); !next.IsZero() && !next.After(now); next = schedule.Next(next) {
+			if len(result) >= limit {
+				break
+			}
+			result = append(result, next)
+		}
+		return result
+	default:
+		return nil
+	}
+}
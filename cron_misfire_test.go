@@ -0,0 +1,150 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package cron_test
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/bborbe/run"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/bborbe/cron"
+	"github.com/bborbe/cron/mocks"
+)
+
+var _ = Describe("MisfirePolicy", func() {
+	var ctx context.Context
+	var cancel context.CancelFunc
+	var clock *cron.FakeClock
+	var stateStore cron.StateStore
+	var options cron.Options
+	var history cron.History
+
+	// executions returns the scheduled times of all executions so far.
+	executions := func() []time.Time {
+		var result []time.Time
+		for _, execution := range history.Executions() {
+			result = append(result, execution.ScheduledTime)
+		}
+		return result
+	}
+
+	// start runs a daily 02:00 cron, the fake clock is at 02:01 on Jan 3rd.
+	start := func() {
+		b := cron.NewExpressionCronWithOptions(
+			"0 0 2 * * ?",
+			run.Func(func(ctx context.Context) error {
+				return nil
+			}),
+			options,
+		)
+		go func() {
+			_ = b.Run(ctx)
+		}()
+		Expect(clock.WaitForTimers(ctx, 1)).To(Succeed())
+	}
+
+	BeforeEach(func() {
+		ctx, cancel = context.WithCancel(context.Background())
+		clock = cron.NewFakeClock(time.Date(2026, 1, 3, 2, 1, 0, 0, time.UTC))
+		stateStore = cron.NewMemoryStateStore()
+		Expect(stateStore.SetLastSuccess(
+			ctx,
+			"misfire-job",
+			time.Date(2025, 12, 30, 2, 0, 0, 0, time.UTC),
+		)).To(Succeed())
+		history = cron.NewHistory(10)
+		options = cron.Options{
+			Name:       "misfire-job",
+			StateStore: stateStore,
+			History:    history,
+			Clock:      clock,
+		}
+	})
+	AfterEach(func() {
+		cancel()
+	})
+	It("skips missed executions by default", func() {
+		start()
+		Consistently(executions).Should(BeEmpty())
+	})
+	It("runs once for missed executions", func() {
+		options.MisfirePolicy = cron.MisfirePolicyRunOnce
+		start()
+		Eventually(executions).Should(Equal([]time.Time{
+			time.Date(2026, 1, 3, 2, 0, 0, 0, time.UTC),
+		}))
+		Consistently(executions).Should(HaveLen(1))
+	})
+	It("records the newest missed execution as last success with run once", func() {
+		options.MisfirePolicy = cron.MisfirePolicyRunOnce
+		start()
+		Eventually(func() time.Time {
+			lastSuccess, err := stateStore.LastSuccess(ctx, "misfire-job")
+			Expect(err).To(BeNil())
+			return lastSuccess
+		}).Should(BeTemporally("==", time.Date(2026, 1, 3, 2, 0, 0, 0, time.UTC)))
+	})
+	It("runs all missed executions up to the limit", func() {
+		options.MisfirePolicy = cron.MisfirePolicyRunAll
+		options.MaxMisfires = 2
+		start()
+		Eventually(executions).Should(Equal([]time.Time{
+			time.Date(2025, 12, 31, 2, 0, 0, 0, time.UTC),
+			time.Date(2026, 1, 1, 2, 0, 0, 0, time.UTC),
+		}))
+	})
+	It("runs all missed executions up to DefaultMaxMisfires without limit", func() {
+		options.MisfirePolicy = cron.MisfirePolicyRunAll
+		start()
+		Eventually(executions).Should(Equal([]time.Time{
+			time.Date(2025, 12, 31, 2, 0, 0, 0, time.UTC),
+			time.Date(2026, 1, 1, 2, 0, 0, 0, time.UTC),
+			time.Date(2026, 1, 2, 2, 0, 0, 0, time.UTC),
+			time.Date(2026, 1, 3, 2, 0, 0, 0, time.UTC),
+		}))
+	})
+	It("records the last success", func() {
+		options.MisfirePolicy = cron.MisfirePolicyRunAll
+		start()
+		Eventually(func() time.Time {
+			lastSuccess, err := stateStore.LastSuccess(ctx, "misfire-job")
+			Expect(err).To(BeNil())
+			return lastSuccess
+		}).Should(BeTemporally("==", time.Date(2026, 1, 3, 2, 0, 0, 0, time.UTC)))
+	})
+	It("keeps a newer last success", func() {
+		Expect(stateStore.SetLastSuccess(
+			ctx,
+			"misfire-job",
+			time.Date(2026, 1, 5, 2, 0, 0, 0, time.UTC),
+		)).To(Succeed())
+		start()
+		clock.Add(24 * time.Hour)
+		Eventually(executions).Should(HaveLen(1))
+		lastSuccess, err := stateStore.LastSuccess(ctx, "misfire-job")
+		Expect(err).To(BeNil())
+		Expect(lastSuccess).To(BeTemporally("==", time.Date(2026, 1, 5, 2, 0, 0, 0, time.UTC)))
+	})
+	It("does not catch up without a previous success", func() {
+		options.MisfirePolicy = cron.MisfirePolicyRunAll
+		options.MaxMisfires = 10
+		options.StateStore = cron.NewMemoryStateStore()
+		start()
+		Consistently(executions).Should(BeEmpty())
+	})
+	It("keeps scheduling if the state store fails", func() {
+		failingStateStore := &mocks.CronStateStore{}
+		failingStateStore.LastSuccessReturns(time.Time{}, errors.New("banana"))
+		options.StateStore = failingStateStore
+		options.MisfirePolicy = cron.MisfirePolicyRunOnce
+		start()
+		clock.Add(24 * time.Hour)
+		Eventually(executions).Should(HaveLen(1))
+	})
+})
@@ -6,10 +6,10 @@ package cron
 
 import (
 	"context"
+	"log/slog"
 
 	"github.com/bborbe/errors"
 	"github.com/bborbe/run"
-	"github.com/golang/glog"
 )
 
 // NewOneTimeCron creates a cron job that executes only once.
@@ -18,7 +18,9 @@ func NewOneTimeCron(
 	action run.Runnable,
 ) run.Runnable {
 	return &cronOneTime{
-		action: action,
+		action:   action,
+		clock:    NewClock(),
+		listener: NopListener{},
 	}
 }
 
@@ -28,21 +30,33 @@ func NewOneTimeCronWithOptions(
 	action run.Runnable,
 	options Options,
 ) run.Runnable {
-	return WrapWithOptions(
-		NewOneTimeCron(action),
-		options,
-	)
+	return &cronOneTime{
+		name:     options.Name,
+		action:   WrapWithOptions(action, options),
+		clock:    options.clockOrDefault(),
+		logger:   options.Logger,
+		listener: options.listenerOrDefault(),
+	}
 }
 
 type cronOneTime struct {
-	action run.Runnable
+	name     string
+	action   run.Runnable
+	clock    Clock
+	logger   *slog.Logger
+	listener Listener
 }
 
 func (c *cronOneTime) Run(ctx context.Context) error {
-	glog.V(4).Infof("run cron action started")
+	defer c.listener.OnShutdown(context.WithoutCancel(ctx), c.name)
+	start := c.clock.Now()
+	ctx = withExecutionInfo(withJobLogger(ctx, c.logger, c.name), c.name, "", start)
+	logger := LoggerFromContext(ctx)
+	logger.Log(ctx, LevelTrace, "run cron action started")
 	if err := c.action.Run(ctx); err != nil {
+		logger.DebugContext(ctx, "run cron action failed", LogKeyDuration, c.clock.Now().Sub(start), LogKeyError, err)
 		return errors.Wrapf(ctx, err, "run cron action failed")
 	}
-	glog.V(4).Infof("run cron action completed")
+	logger.Log(ctx, LevelTrace, "run cron action completed", LogKeyDuration, c.clock.Now().Sub(start))
 	return nil
 }
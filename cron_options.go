@@ -5,7 +5,12 @@
 package cron
 
 import (
+	"log/slog"
+	"time"
+
+	libsentry "github.com/bborbe/sentry"
 	libtime "github.com/bborbe/time"
+	"go.opentelemetry.io/otel/trace"
 )
 
 // Options configures behavior for cron jobs with wrappers applied.
@@ -14,20 +19,138 @@ type Options struct {
 	Name string
 	// EnableMetrics enables duration and execution metrics collection.
 	EnableMetrics bool
+	// Metrics receives the metrics of the cron, e.g. created by NewMetricsWithRegisterer
+	// for an isolated registry, a custom namespace or const labels. Nil uses NewMetrics.
+	// Metrics are only collected with EnableMetrics.
+	Metrics Metrics
 	// Timeout sets the maximum duration allowed for individual action executions.
 	// A value of 0 disables timeout enforcement.
 	Timeout libtime.Duration
+	// Retry configures retries of failed executions. A MaxAttempts below 2 disables retries.
+	Retry RetryOptions
+	// RecoverPanics converts panics of the action into errors handled like any failed execution.
+	RecoverPanics bool
 	// ParallelSkip prevents multiple instances of the same cron from running concurrently.
+	// It is a shortcut for OverlapPolicySkip and ignored if OverlapPolicy is set.
 	ParallelSkip bool
+	// OverlapPolicy decides what happens if an execution is due while the previous one is still running.
+	// An empty value behaves like OverlapPolicyAllow unless ParallelSkip is set.
+	OverlapPolicy OverlapPolicy
+	// Location sets the time zone cron expressions are evaluated in.
+	// Nil uses the process local time. A CRON_TZ= or TZ= prefix in the expression takes precedence.
+	// On DST transitions a wall-clock time that does not exist is skipped for that day,
+	// and a wall-clock time that occurs twice fires twice.
+	Location *time.Location
+	// Jitter sets the maximum random delay applied before each execution of recurring crons.
+	// It spreads replicas or jobs sharing the same schedule. A value of 0 disables jitter.
+	Jitter libtime.Duration
+	// JitterDeterministic derives the jitter delay from Name instead of picking it randomly,
+	// so a job keeps its offset across restarts while differently named jobs are spread out.
+	JitterDeterministic bool
+	// ErrorPolicy decides whether recurring crons stop or continue after a failed execution.
+	// An empty value behaves like ErrorPolicyStop. One-time crons always return the error.
+	ErrorPolicy ErrorPolicy
+	// MaxConsecutiveFailures is the number of failed executions in a row that stops the cron
+	// with ErrorPolicyStopAfterConsecutiveFailures. Values below 1, including the zero value,
+	// stop the cron on the first failure like ErrorPolicyStop.
+	MaxConsecutiveFailures int
+	// StateStore persists the scheduled time of the last successful execution of expression crons.
+	// Nil disables persistence and catch-up.
+	StateStore StateStore
+	// MisfirePolicy decides whether expression crons catch up on executions missed since
+	// the last success recorded in StateStore. An empty value behaves like MisfirePolicySkip.
+	MisfirePolicy MisfirePolicy
+	// MaxMisfires limits the number of executions caught up with MisfirePolicyRunAll.
+	// A value of 0 uses DefaultMaxMisfires.
+	MaxMisfires int
+	// MisfireThreshold skips scheduled executions of recurring crons starting later than this
+	// after their scheduled time, e.g. because the process was starved. A value of 0 disables it.
+	// The delay is reported as cron_job_delay_seconds, skipped executions as cron_job_missed_total.
+	// Catch-up executions of the MisfirePolicy are not affected.
+	MisfireThreshold libtime.Duration
+	// TracerProvider starts an OpenTelemetry span per execution. Nil disables tracing.
+	TracerProvider trace.TracerProvider
+	// Logger receives the structured logs of the cron and its wrappers.
+	// Nil uses the logger of the context set by ContextWithLogger or the default logger writing to glog.
+	Logger *slog.Logger
+	// Listener receives the lifecycle events of the cron. Use Listeners to register several.
+	// Nil disables events.
+	Listener Listener
+	// SentryClient captures failed executions in Sentry. Nil disables it.
+	// If it implements SentryCheckInClient, it also sends Sentry Crons check-ins.
+	SentryClient libsentry.Client
+	// Heartbeat pings the start, success and failure of every execution to an external watchdog,
+	// e.g. created by NewHTTPPinger. Nil disables heartbeats.
+	Heartbeat Pinger
+	// Locker takes a lock named after the cron before each execution, for expression crons
+	// also after the scheduled time, so only one replica runs it. Executions are skipped
+	// if the lock is held elsewhere.
+	// Nil disables locking.
+	Locker Locker
+	// LockTTL is the validity of the lock, refreshed every half LockTTL while the execution runs
+	// and kept for LockTTL after executions of expression crons.
+	// A value of 0 uses DefaultLockTTL.
+	LockTTL libtime.Duration
+	// History records the executions of the cron. Nil disables the history.
+	History History
+	// Clock provides the time source for scheduling.
+	// Nil uses NewClock, which reads libtime.Now and real timers.
+	Clock Clock
 }
 
 // DefaultOptions returns a new Options with default values.
 // The default configuration has metrics disabled, no timeout, and allows parallel execution.
 func DefaultOptions() Options {
 	return Options{
-		Name:          "unnamed-cron",
-		EnableMetrics: false,
-		Timeout:       0, // disabled
-		ParallelSkip:  false,
+		Name:             "unnamed-cron",
+		EnableMetrics:    false,
+		Metrics:          nil, // NewMetrics()
+		Timeout:          0,   // disabled
+		Retry:            RetryOptions{},
+		RecoverPanics:    false,
+		ParallelSkip:     false,
+		OverlapPolicy:    "",  // OverlapPolicyAllow, OverlapPolicySkip with ParallelSkip
+		Location:         nil, // process local time
+		Jitter:           0,   // disabled
+		ErrorPolicy:      ErrorPolicyStop,
+		StateStore:       nil, // disabled
+		MisfirePolicy:    MisfirePolicySkip,
+		MisfireThreshold: 0,   // disabled
+		TracerProvider:   nil, // disabled
+		Logger:           nil, // logger of the context or glog
+		Listener:         nil, // disabled
+		SentryClient:     nil, // disabled
+		Heartbeat:        nil, // disabled
+		Locker:           nil, // disabled
+		LockTTL:          DefaultLockTTL,
+		History:          nil, // disabled
+		Clock:            nil, // NewClock()
+	}
+}
+
+// enabledMetrics returns Options.Metrics or NewMetrics if EnableMetrics is set,
+// and metrics discarding everything otherwise.
+func (o Options) enabledMetrics() ExtendedMetrics {
+	if !o.EnableMetrics {
+		return nopMetrics{}
+	}
+	if o.Metrics != nil {
+		return extendMetrics(o.Metrics)
+	}
+	return extendMetrics(NewMetrics())
+}
+
+// listenerOrDefault returns Options.Listener or a NopListener if it is nil.
+func (o Options) listenerOrDefault() Listener {
+	if o.Listener != nil {
+		return o.Listener
+	}
+	return NopListener{}
+}
+
+func (o Options) clockOrDefault() Clock {
+	if o.Clock != nil {
+		return o.Clock
 	}
+	return NewClock()
 }
@@ -20,7 +20,29 @@ var _ = Describe("Options", func() {
 			Expect(options.Name).To(Equal("unnamed-cron"))
 			Expect(options.EnableMetrics).To(BeFalse())
 			Expect(options.Timeout).To(Equal(libtime.Duration(0)))
+			Expect(options.Retry).To(Equal(cron.RetryOptions{}))
+			Expect(options.RecoverPanics).To(BeFalse())
 			Expect(options.ParallelSkip).To(BeFalse())
+			Expect(options.OverlapPolicy).To(BeEmpty())
+			Expect(options.Location).To(BeNil())
+			Expect(options.Jitter).To(Equal(libtime.Duration(0)))
+			Expect(options.JitterDeterministic).To(BeFalse())
+			Expect(options.ErrorPolicy).To(Equal(cron.ErrorPolicyStop))
+			Expect(options.MaxConsecutiveFailures).To(Equal(0))
+			Expect(options.StateStore).To(BeNil())
+			Expect(options.MisfirePolicy).To(Equal(cron.MisfirePolicySkip))
+			Expect(options.MaxMisfires).To(Equal(0))
+			Expect(options.MisfireThreshold).To(Equal(libtime.Duration(0)))
+			Expect(options.History).To(BeNil())
+			Expect(options.Metrics).To(BeNil())
+			Expect(options.TracerProvider).To(BeNil())
+			Expect(options.Logger).To(BeNil())
+			Expect(options.Listener).To(BeNil())
+			Expect(options.SentryClient).To(BeNil())
+			Expect(options.Heartbeat).To(BeNil())
+			Expect(options.Locker).To(BeNil())
+			Expect(options.LockTTL).To(Equal(cron.DefaultLockTTL))
+			Expect(options.Clock).To(BeNil())
 		})
 	})
 
@@ -0,0 +1,126 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package cron
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/bborbe/errors"
+	libtime "github.com/bborbe/time"
+)
+
+//counterfeiter:generate -o mocks/cron-pinger.go --fake-name CronPinger . Pinger
+
+// Pinger reports executions to an external watchdog like healthchecks.io,
+// which alerts if the success pings of a job stop arriving.
+type Pinger interface {
+	// PingStart reports that an execution started.
+	PingStart(ctx context.Context) error
+	// PingSuccess reports that an execution succeeded.
+	PingSuccess(ctx context.Context) error
+	// PingFail reports that an execution failed with the given error.
+	PingFail(ctx context.Context, err error) error
+}
+
+// HTTPPingerOptions configures the URLs and the delivery of NewHTTPPinger.
+type HTTPPingerOptions struct {
+	// StartURL is requested when an execution starts. Empty skips the start ping.
+	StartURL string
+	// SuccessURL is requested when an execution succeeded. Empty skips the success ping.
+	SuccessURL string
+	// FailURL is requested with the error message as body when an execution failed.
+	// Empty skips the fail ping.
+	FailURL string
+	// Timeout limits each request. A value of 0 uses 10 seconds.
+	Timeout libtime.Duration
+	// Retries is the number of additional attempts after a failed request.
+	Retries int
+	// RetryDelay is the delay between two attempts.
+	RetryDelay libtime.Duration
+	// HTTPClient sends the requests. Nil uses http.DefaultClient.
+	HTTPClient *http.Client
+	// Clock provides the timers for the retry delay. Nil uses NewClock.
+	Clock Clock
+}
+
+// NewHTTPPinger returns a Pinger sending POST requests to the configured URLs,
+// e.g. https://hc-ping.com/<uuid>, https://hc-ping.com/<uuid>/start and https://hc-ping.com/<uuid>/fail.
+// Responses other than 2xx are errors and retried like failed requests.
+func NewHTTPPinger(options HTTPPingerOptions) Pinger {
+	if options.Timeout <= 0 {
+		options.Timeout = 10 * libtime.Second
+	}
+	if options.HTTPClient == nil {
+		options.HTTPClient = http.DefaultClient
+	}
+	if options.Clock == nil {
+		options.Clock = NewClock()
+	}
+	return &httpPinger{
+		options: options,
+	}
+}
+
+type httpPinger struct {
+	options HTTPPingerOptions
+}
+
+func (h *httpPinger) PingStart(ctx context.Context) error {
+	return h.ping(ctx, h.options.StartURL, "")
+}
+
+func (h *httpPinger) PingSuccess(ctx context.Context) error {
+	return h.ping(ctx, h.options.SuccessURL, "")
+}
+
+func (h *httpPinger) PingFail(ctx context.Context, err error) error {
+	return h.ping(ctx, h.options.FailURL, err.Error())
+}
+
+// ping requests the url with the given body and retries failed requests.
+func (h *httpPinger) ping(ctx context.Context, url string, body string) error {
+	if url == "" {
+		return nil
+	}
+	for attempt := 0; ; attempt++ {
+		err := h.request(ctx, url, body)
+		if err == nil {
+			return nil
+		}
+		if attempt >= h.options.Retries || ctx.Err() != nil {
+			return errors.Wrapf(ctx, err, "ping failed after %d attempts", attempt+1)
+		}
+		timer := h.options.Clock.NewTimer(h.options.RetryDelay.Duration())
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return errors.Wrapf(ctx, err, "ping failed after %d attempts", attempt+1)
+		case <-timer.C():
+		}
+	}
+}
+
+func (h *httpPinger) request(ctx context.Context, url string, body string) error {
+	ctx, cancel := context.WithTimeout(ctx, h.options.Timeout.Duration())
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, strings.NewReader(body))
+	if err != nil {
+		return errors.Wrapf(ctx, err, "create request failed")
+	}
+	req.Header.Set("Content-Type", "text/plain; charset=utf-8")
+	resp, err := h.options.HTTPClient.Do(req)
+	if err != nil {
+		return errors.Wrapf(ctx, err, "request failed")
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, resp.Body)
+	if resp.StatusCode/100 != 2 {
+		return errors.Errorf(ctx, "request failed with status %d", resp.StatusCode)
+	}
+	return nil
+}
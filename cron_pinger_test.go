@@ -0,0 +1,136 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package cron_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"time"
+
+	libtime "github.com/bborbe/time"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/bborbe/cron"
+)
+
+var _ = Describe("HTTPPinger", func() {
+	var ctx context.Context
+	var server *httptest.Server
+	var mux sync.Mutex
+	var requests []string
+	var bodies []string
+	var statusCodes []int
+
+	// received returns the paths requested so far.
+	received := func() []string {
+		mux.Lock()
+		defer mux.Unlock()
+		return append([]string{}, requests...)
+	}
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		mux.Lock()
+		requests = nil
+		bodies = nil
+		statusCodes = nil
+		mux.Unlock()
+		server = httptest.NewServer(http.HandlerFunc(func(resp http.ResponseWriter, req *http.Request) {
+			body, _ := io.ReadAll(req.Body)
+			mux.Lock()
+			defer mux.Unlock()
+			requests = append(requests, req.Method+" "+req.URL.Path)
+			bodies = append(bodies, string(body))
+			statusCode := http.StatusOK
+			if len(statusCodes) > 0 {
+				statusCode = statusCodes[0]
+				statusCodes = statusCodes[1:]
+			}
+			resp.WriteHeader(statusCode)
+		}))
+	})
+	AfterEach(func() {
+		server.Close()
+	})
+
+	It("pings start, success and fail urls", func() {
+		pinger := cron.NewHTTPPinger(cron.HTTPPingerOptions{
+			StartURL:   server.URL + "/check/start",
+			SuccessURL: server.URL + "/check",
+			FailURL:    server.URL + "/check/fail",
+		})
+		Expect(pinger.PingStart(ctx)).To(Succeed())
+		Expect(pinger.PingSuccess(ctx)).To(Succeed())
+		Expect(pinger.PingFail(ctx, errors.New("banana"))).To(Succeed())
+
+		Expect(received()).To(Equal([]string{
+			"POST /check/start",
+			"POST /check",
+			"POST /check/fail",
+		}))
+		mux.Lock()
+		defer mux.Unlock()
+		Expect(bodies[2]).To(Equal("banana"))
+	})
+
+	It("skips empty urls", func() {
+		pinger := cron.NewHTTPPinger(cron.HTTPPingerOptions{
+			SuccessURL: server.URL + "/check",
+		})
+		Expect(pinger.PingStart(ctx)).To(Succeed())
+		Expect(pinger.PingFail(ctx, errors.New("banana"))).To(Succeed())
+		Expect(received()).To(BeEmpty())
+	})
+
+	It("retries failed requests", func() {
+		mux.Lock()
+		statusCodes = []int{http.StatusInternalServerError, http.StatusBadGateway}
+		mux.Unlock()
+		pinger := cron.NewHTTPPinger(cron.HTTPPingerOptions{
+			SuccessURL: server.URL + "/check",
+			Retries:    2,
+			RetryDelay: libtime.Millisecond,
+		})
+		Expect(pinger.PingSuccess(ctx)).To(Succeed())
+		Expect(received()).To(HaveLen(3))
+	})
+
+	It("returns an error after the last retry", func() {
+		mux.Lock()
+		statusCodes = []int{http.StatusInternalServerError, http.StatusInternalServerError}
+		mux.Unlock()
+		pinger := cron.NewHTTPPinger(cron.HTTPPingerOptions{
+			SuccessURL: server.URL + "/check",
+			Retries:    1,
+			RetryDelay: libtime.Millisecond,
+		})
+		Expect(pinger.PingSuccess(ctx)).To(MatchError(ContainSubstring("status 500")))
+		Expect(received()).To(HaveLen(2))
+	})
+
+	It("times out slow requests", func() {
+		release := make(chan struct{})
+		defer close(release)
+		slowServer := httptest.NewServer(http.HandlerFunc(func(resp http.ResponseWriter, req *http.Request) {
+			select {
+			case <-release:
+			case <-req.Context().Done():
+			}
+		}))
+		defer slowServer.Close()
+		pinger := cron.NewHTTPPinger(cron.HTTPPingerOptions{
+			SuccessURL: slowServer.URL + "/check",
+			Timeout:    10 * libtime.Millisecond,
+		})
+		start := time.Now()
+		Expect(pinger.PingSuccess(ctx)).NotTo(Succeed())
+		Expect(time.Since(start)).To(BeNumerically("<", time.Second))
+	})
+})
@@ -0,0 +1,371 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package cron
+
+import (
+	"context"
+	stderrors "errors"
+	"sort"
+	"sync"
+
+	"github.com/bborbe/errors"
+	"github.com/bborbe/run"
+	libtime "github.com/bborbe/time"
+)
+
+// ErrJobNotFound is returned by a Scheduler if no job with the given name is registered.
+var ErrJobNotFound = stderrors.New("job not found")
+
+// Job describes a named cron managed by a Scheduler.
+// The execution strategy is selected like in NewCronJobWithOptions.
+type Job struct {
+	// Name identifies the job in the Scheduler. It overrides Options.Name.
+	Name string
+	// OneTime runs the action once. The job is removed after it completed.
+	OneTime bool
+	// Expression schedules the action by a cron expression.
+	Expression Expression
+	// Wait schedules the action at a fixed interval if no Expression is set.
+	Wait libtime.Duration
+	// Action is executed on every run.
+	Action run.Runnable
+	// Options configures the wrappers applied to the action.
+	// A Scheduler keeps DefaultHistorySize executions if Options.History is nil.
+	Options Options
+}
+
+// Validate returns an error if the job has no name or no action.
+func (j Job) Validate(ctx context.Context) error {
+	if j.Name == "" {
+		return errors.Errorf(ctx, "name of job is empty")
+	}
+	if j.Action == nil {
+		return errors.Errorf(ctx, "action of job '%s' is nil", j.Name)
+	}
+	return nil
+}
+
+// JobInfo describes a job registered in a Scheduler and its current state.
+type JobInfo struct {
+	// Name identifies the job in the Scheduler.
+	Name string `json:"name"`
+	// OneTime is true if the job runs only once.
+	OneTime bool `json:"oneTime,omitempty"`
+	// Expression is the cron expression of the job, if any.
+	Expression Expression `json:"expression,omitempty"`
+	// Interval is the wait duration of interval jobs, if any.
+	Interval string `json:"interval,omitempty"`
+	// JobState is the current state. It is empty for one-time jobs.
+	JobState
+}
+
+//counterfeiter:generate -o mocks/cron-scheduler.go --fake-name CronScheduler . Scheduler
+
+// Scheduler runs many named jobs under a single Run and allows to change them while running.
+type Scheduler interface {
+	// Run starts all jobs and blocks until the context is cancelled or a job failed.
+	// Before returning it cancels all jobs and waits for their in-flight executions.
+	run.Runnable
+	// Add registers a new job. It is started immediately if the scheduler is running.
+	Add(ctx context.Context, job Job) error
+	// Remove stops the job with the given name and waits for its in-flight executions.
+	Remove(ctx context.Context, name string) error
+	// Replace stops the job with the same name, waits for its in-flight executions
+	// and starts the given job instead. The job is added if no job with the name exists.
+	Replace(ctx context.Context, job Job) error
+	// Names returns the sorted names of all registered jobs.
+	Names() []string
+	// Jobs returns all registered jobs sorted by name.
+	Jobs() []JobInfo
+	// History returns the execution history of the job with the given name.
+	History(ctx context.Context, name string) (History, error)
+	// Controller returns the Controller of the job with the given name.
+	// One-time jobs do not support controls.
+	Controller(ctx context.Context, name string) (Controller, error)
+	// Trigger starts an execution of the job with the given name in the background
+	// like Controller.TriggerNow. The execution is cancelled if the job is removed or replaced
+	// or the scheduler stops, which wait for it. It fails if the scheduler is not running.
+	Trigger(ctx context.Context, name string) error
+}
+
+// NewScheduler returns a Scheduler without jobs.
+func NewScheduler() Scheduler {
+	return &scheduler{
+		jobs: make(map[string]*schedulerJob),
+	}
+}
+
+type scheduler struct {
+	mux     sync.Mutex
+	jobs    map[string]*schedulerJob
+	ctx     context.Context
+	errChan chan error
+}
+
+func newSchedulerJob(job Job) *schedulerJob {
+	options := job.Options
+	options.Name = job.Name
+	if options.History == nil {
+		options.History = NewHistory(DefaultHistorySize)
+	}
+	return &schedulerJob{
+		job:     job,
+		cron:    NewCronJobWithOptions(job.OneTime, job.Expression, job.Wait, job.Action, options),
+		history: options.History,
+	}
+}
+
+type schedulerJob struct {
+	job     Job
+	cron    run.Runnable
+	history History
+	// ctx, cancel and done are set while the job is running and guarded by the scheduler lock.
+	ctx    context.Context
+	cancel context.CancelFunc
+	done   chan struct{}
+	// triggers tracks the executions started by Trigger.
+	triggers sync.WaitGroup
+}
+
+func (j *schedulerJob) info() JobInfo {
+	info := JobInfo{
+		Name:       j.job.Name,
+		OneTime:    j.job.OneTime,
+		Expression: j.job.Expression,
+	}
+	if !j.job.OneTime && len(j.job.Expression) == 0 {
+		info.Interval = j.job.Wait.Duration().String()
+	}
+	if controller, ok := j.cron.(Controller); ok {
+		info.JobState = controller.State()
+	}
+	return info
+}
+
+// stop cancels the job and its triggered executions and waits until they returned.
+// It does nothing if the job is not started.
+func (s *scheduler) stop(schedulerJob *schedulerJob) {
+	s.mux.Lock()
+	cancel := schedulerJob.cancel
+	done := schedulerJob.done
+	// no further triggers, so waiting for them cannot race with adding one
+	schedulerJob.ctx = nil
+	s.mux.Unlock()
+	if cancel == nil {
+		return
+	}
+	cancel()
+	<-done
+	schedulerJob.triggers.Wait()
+}
+
+func (s *scheduler) Run(ctx context.Context) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	s.mux.Lock()
+	if s.ctx != nil {
+		s.mux.Unlock()
+		return errors.Errorf(ctx, "scheduler is already running")
+	}
+	s.ctx = ctx
+	errChan := make(chan error, 1)
+	s.errChan = errChan
+	for _, job := range s.jobs {
+		s.start(job)
+	}
+	s.mux.Unlock()
+	logger := LoggerFromContext(ctx)
+	logger.InfoContext(ctx, "scheduler started")
+
+	var err error
+	select {
+	case <-ctx.Done():
+	case err = <-errChan:
+	}
+
+	logger.InfoContext(ctx, "stopping scheduler started")
+	cancel()
+	s.mux.Lock()
+	s.ctx = nil
+	s.errChan = nil
+	jobs := make([]*schedulerJob, 0, len(s.jobs))
+	for _, job := range s.jobs {
+		jobs = append(jobs, job)
+	}
+	s.mux.Unlock()
+	for _, job := range jobs {
+		s.stop(job)
+	}
+	logger.InfoContext(ctx, "stopping scheduler completed")
+	return err
+}
+
+func (s *scheduler) Add(ctx context.Context, job Job) error {
+	if err := job.Validate(ctx); err != nil {
+		return errors.Wrap(ctx, err, "validate job failed")
+	}
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	if _, ok := s.jobs[job.Name]; ok {
+		return errors.Errorf(ctx, "job '%s' already exists", job.Name)
+	}
+	schedulerJob := newSchedulerJob(job)
+	s.jobs[job.Name] = schedulerJob
+	if s.ctx != nil {
+		s.start(schedulerJob)
+	}
+	jobLogger(ctx, job.Name).InfoContext(ctx, "job added")
+	return nil
+}
+
+func (s *scheduler) Remove(ctx context.Context, name string) error {
+	s.mux.Lock()
+	schedulerJob, ok := s.jobs[name]
+	if !ok {
+		s.mux.Unlock()
+		return errors.Wrapf(ctx, ErrJobNotFound, "job '%s'", name)
+	}
+	delete(s.jobs, name)
+	s.mux.Unlock()
+
+	s.stop(schedulerJob)
+	jobLogger(ctx, name).InfoContext(ctx, "job removed")
+	return nil
+}
+
+func (s *scheduler) Replace(ctx context.Context, job Job) error {
+	if err := job.Validate(ctx); err != nil {
+		return errors.Wrap(ctx, err, "validate job failed")
+	}
+	schedulerJob := newSchedulerJob(job)
+	s.mux.Lock()
+	previous := s.jobs[job.Name]
+	s.jobs[job.Name] = schedulerJob
+	s.mux.Unlock()
+
+	if previous != nil {
+		s.stop(previous)
+	}
+
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	if s.ctx != nil && s.jobs[job.Name] == schedulerJob && schedulerJob.cancel == nil {
+		s.start(schedulerJob)
+	}
+	jobLogger(ctx, job.Name).InfoContext(ctx, "job replaced")
+	return nil
+}
+
+func (s *scheduler) Names() []string {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	names := make([]string, 0, len(s.jobs))
+	for name := range s.jobs {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func (s *scheduler) Jobs() []JobInfo {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	result := make([]JobInfo, 0, len(s.jobs))
+	for _, schedulerJob := range s.jobs {
+		result = append(result, schedulerJob.info())
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Name < result[j].Name
+	})
+	return result
+}
+
+func (s *scheduler) History(ctx context.Context, name string) (History, error) {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	schedulerJob, ok := s.jobs[name]
+	if !ok {
+		return nil, errors.Wrapf(ctx, ErrJobNotFound, "job '%s'", name)
+	}
+	return schedulerJob.history, nil
+}
+
+func (s *scheduler) Controller(ctx context.Context, name string) (Controller, error) {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	schedulerJob, ok := s.jobs[name]
+	if !ok {
+		return nil, errors.Wrapf(ctx, ErrJobNotFound, "job '%s'", name)
+	}
+	controller, ok := schedulerJob.cron.(Controller)
+	if !ok {
+		return nil, errors.Errorf(ctx, "job '%s' does not support controls", name)
+	}
+	return controller, nil
+}
+
+func (s *scheduler) Trigger(ctx context.Context, name string) error {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	schedulerJob, ok := s.jobs[name]
+	if !ok {
+		return errors.Wrapf(ctx, ErrJobNotFound, "job '%s'", name)
+	}
+	controller, ok := schedulerJob.cron.(Controller)
+	if !ok {
+		return errors.Errorf(ctx, "job '%s' does not support controls", name)
+	}
+	jobCtx := schedulerJob.ctx
+	if jobCtx == nil {
+		return errors.Errorf(ctx, "job '%s' is not running", name)
+	}
+	schedulerJob.triggers.Add(1)
+	go func() {
+		defer schedulerJob.triggers.Done()
+		if err := controller.TriggerNow(jobCtx); err != nil {
+			jobLogger(jobCtx, name).WarnContext(jobCtx, "triggered execution failed", LogKeyError, err)
+		}
+	}()
+	return nil
+}
+
+// start runs the job in the background; the caller must hold the lock.
+func (s *scheduler) start(schedulerJob *schedulerJob) {
+	ctx, cancel := context.WithCancel(s.ctx)
+	done := make(chan struct{})
+	schedulerJob.ctx = ctx
+	schedulerJob.cancel = cancel
+	schedulerJob.done = done
+	errChan := s.errChan
+
+	job := schedulerJob.job
+	cronJob := schedulerJob.cron
+
+	go func() {
+		defer close(done)
+		defer cancel()
+		logger := jobLogger(ctx, job.Name)
+		logger.DebugContext(ctx, "job started")
+		err := cronJob.Run(ctx)
+		if ctx.Err() != nil {
+			logger.DebugContext(ctx, "job stopped")
+			return
+		}
+		if err != nil {
+			select {
+			case errChan <- errors.Wrapf(ctx, err, "job '%s' failed", job.Name):
+			default:
+			}
+			return
+		}
+		logger.DebugContext(ctx, "job completed")
+		s.mux.Lock()
+		defer s.mux.Unlock()
+		if s.jobs[job.Name] == schedulerJob {
+			delete(s.jobs, job.Name)
+		}
+	}()
+}
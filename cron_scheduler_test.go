@@ -0,0 +1,222 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package cron_test
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"time"
+
+	"github.com/bborbe/run"
+	libtime "github.com/bborbe/time"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/bborbe/cron"
+)
+
+var _ = Describe("Scheduler", func() {
+	var ctx context.Context
+	var cancel context.CancelFunc
+	var clock *cron.FakeClock
+	var scheduler cron.Scheduler
+	var result chan error
+
+	// intervalJob returns a job running every minute that counts its executions.
+	intervalJob := func(name string, counter *int64) cron.Job {
+		return cron.Job{
+			Name: name,
+			Wait: libtime.Minute,
+			Action: run.Func(func(ctx context.Context) error {
+				atomic.AddInt64(counter, 1)
+				return nil
+			}),
+			Options: cron.Options{
+				Clock: clock,
+			},
+		}
+	}
+
+	// start runs the scheduler in the background.
+	start := func() {
+		go func(ctx context.Context, result chan<- error) {
+			result <- scheduler.Run(ctx)
+		}(ctx, result)
+	}
+
+	BeforeEach(func() {
+		ctx, cancel = context.WithCancel(context.Background())
+		clock = cron.NewFakeClock(time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC))
+		scheduler = cron.NewScheduler()
+		result = make(chan error, 1)
+	})
+	AfterEach(func() {
+		cancel()
+	})
+	It("runs all added jobs", func() {
+		var first, second int64
+		Expect(scheduler.Add(ctx, intervalJob("first", &first))).To(Succeed())
+		Expect(scheduler.Add(ctx, intervalJob("second", &second))).To(Succeed())
+		start()
+		Expect(clock.WaitForTimers(ctx, 2)).To(Succeed())
+		Expect(atomic.LoadInt64(&first)).To(Equal(int64(1)))
+		Expect(atomic.LoadInt64(&second)).To(Equal(int64(1)))
+
+		clock.Add(time.Minute)
+		Expect(clock.WaitForTimers(ctx, 2)).To(Succeed())
+		Expect(atomic.LoadInt64(&first)).To(Equal(int64(2)))
+		Expect(atomic.LoadInt64(&second)).To(Equal(int64(2)))
+	})
+	It("returns the sorted names", func() {
+		var counter int64
+		Expect(scheduler.Add(ctx, intervalJob("b", &counter))).To(Succeed())
+		Expect(scheduler.Add(ctx, intervalJob("a", &counter))).To(Succeed())
+		Expect(scheduler.Names()).To(Equal([]string{"a", "b"}))
+	})
+	It("rejects a duplicate name", func() {
+		var counter int64
+		Expect(scheduler.Add(ctx, intervalJob("job", &counter))).To(Succeed())
+		Expect(scheduler.Add(ctx, intervalJob("job", &counter))).NotTo(Succeed())
+	})
+	It("rejects an invalid job", func() {
+		Expect(scheduler.Add(ctx, cron.Job{Name: "job"})).NotTo(Succeed())
+		Expect(scheduler.Add(ctx, cron.Job{Action: run.Func(func(ctx context.Context) error {
+			return nil
+		})})).NotTo(Succeed())
+	})
+	It("starts jobs added while running", func() {
+		start()
+		var counter int64
+		Expect(scheduler.Add(ctx, intervalJob("late", &counter))).To(Succeed())
+		Expect(clock.WaitForTimers(ctx, 1)).To(Succeed())
+		Expect(atomic.LoadInt64(&counter)).To(Equal(int64(1)))
+	})
+	It("stops removed jobs", func() {
+		var counter int64
+		Expect(scheduler.Add(ctx, intervalJob("job", &counter))).To(Succeed())
+		start()
+		Expect(clock.WaitForTimers(ctx, 1)).To(Succeed())
+		Expect(scheduler.Remove(ctx, "job")).To(Succeed())
+		Expect(clock.Timers()).To(Equal(0))
+		Expect(scheduler.Names()).To(BeEmpty())
+		Consistently(result).ShouldNot(Receive())
+	})
+	It("removes a job while the scheduler starts", func() {
+		var counter int64
+		Expect(scheduler.Add(ctx, intervalJob("job", &counter))).To(Succeed())
+		start()
+		Expect(scheduler.Remove(ctx, "job")).To(Succeed())
+		Expect(scheduler.Names()).To(BeEmpty())
+		cancel()
+		Eventually(result).Should(Receive(BeNil()))
+		Expect(clock.Timers()).To(Equal(0))
+	})
+	It("fails to remove an unknown job", func() {
+		Expect(scheduler.Remove(ctx, "unknown")).NotTo(Succeed())
+	})
+	It("replaces a running job", func() {
+		var before, after int64
+		Expect(scheduler.Add(ctx, intervalJob("job", &before))).To(Succeed())
+		start()
+		Expect(clock.WaitForTimers(ctx, 1)).To(Succeed())
+		Expect(scheduler.Replace(ctx, intervalJob("job", &after))).To(Succeed())
+		Expect(clock.WaitForTimers(ctx, 1)).To(Succeed())
+		Expect(clock.Timers()).To(Equal(1))
+		Expect(atomic.LoadInt64(&before)).To(Equal(int64(1)))
+		Expect(atomic.LoadInt64(&after)).To(Equal(int64(1)))
+		Expect(scheduler.Names()).To(Equal([]string{"job"}))
+	})
+	It("removes completed one-time jobs", func() {
+		done := make(chan struct{})
+		Expect(scheduler.Add(ctx, cron.Job{
+			Name:    "once",
+			OneTime: true,
+			Action: run.Func(func(ctx context.Context) error {
+				close(done)
+				return nil
+			}),
+		})).To(Succeed())
+		start()
+		Eventually(done).Should(BeClosed())
+		Eventually(scheduler.Names).Should(BeEmpty())
+	})
+	It("returns the error of a failed job", func() {
+		actionErr := errors.New("banana")
+		Expect(scheduler.Add(ctx, cron.Job{
+			Name:    "failing",
+			OneTime: true,
+			Action: run.Func(func(ctx context.Context) error {
+				return actionErr
+			}),
+		})).To(Succeed())
+		start()
+		var err error
+		Eventually(result).Should(Receive(&err))
+		Expect(errors.Is(err, actionErr)).To(BeTrue())
+		Expect(err.Error()).To(ContainSubstring("job 'failing' failed"))
+	})
+	It("waits for in-flight executions on shutdown", func() {
+		started := make(chan struct{})
+		var finished atomic.Bool
+		Expect(scheduler.Add(ctx, cron.Job{
+			Name:    "slow",
+			OneTime: true,
+			Action: run.Func(func(ctx context.Context) error {
+				close(started)
+				<-ctx.Done()
+				time.Sleep(10 * time.Millisecond)
+				finished.Store(true)
+				return nil
+			}),
+		})).To(Succeed())
+		start()
+		Eventually(started).Should(BeClosed())
+		cancel()
+		Eventually(result).Should(Receive(BeNil()))
+		Expect(finished.Load()).To(BeTrue())
+	})
+	It("waits for triggered executions on shutdown", func() {
+		var counter int64
+		started := make(chan struct{})
+		var finished atomic.Bool
+		job := intervalJob("job", &counter)
+		job.Action = run.Func(func(ctx context.Context) error {
+			if atomic.AddInt64(&counter, 1) == 1 {
+				return nil
+			}
+			close(started)
+			<-ctx.Done()
+			time.Sleep(10 * time.Millisecond)
+			finished.Store(true)
+			return nil
+		})
+		Expect(scheduler.Add(ctx, job)).To(Succeed())
+		start()
+		Expect(clock.WaitForTimers(ctx, 1)).To(Succeed())
+		Expect(scheduler.Trigger(ctx, "job")).To(Succeed())
+		Eventually(started).Should(BeClosed())
+		cancel()
+		Eventually(result).Should(Receive(BeNil()))
+		Expect(finished.Load()).To(BeTrue())
+	})
+	It("fails to trigger a job if not running", func() {
+		var counter int64
+		Expect(scheduler.Add(ctx, intervalJob("job", &counter))).To(Succeed())
+		Expect(scheduler.Trigger(ctx, "job")).NotTo(Succeed())
+		Expect(atomic.LoadInt64(&counter)).To(Equal(int64(0)))
+	})
+	It("fails to trigger an unknown job", func() {
+		err := scheduler.Trigger(ctx, "unknown")
+		Expect(errors.Is(err, cron.ErrJobNotFound)).To(BeTrue())
+	})
+	It("fails if already running", func() {
+		var counter int64
+		Expect(scheduler.Add(ctx, intervalJob("job", &counter))).To(Succeed())
+		start()
+		Expect(clock.WaitForTimers(ctx, 1)).To(Succeed())
+		Expect(scheduler.Run(ctx)).NotTo(Succeed())
+	})
+})
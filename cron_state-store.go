@@ -0,0 +1,129 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package cron
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/bborbe/errors"
+)
+
+//counterfeiter:generate -o mocks/cron-state-store.go --fake-name CronStateStore . StateStore
+
+// StateStore persists the scheduled time of the last successful execution per cron name,
+// so missed executions can be detected after a restart.
+type StateStore interface {
+	// LastSuccess returns the scheduled time of the last successful execution.
+	// It returns the zero time if the cron never succeeded.
+	LastSuccess(ctx context.Context, name string) (time.Time, error)
+	// SetLastSuccess records the scheduled time of a successful execution.
+	SetLastSuccess(ctx context.Context, name string, scheduledTime time.Time) error
+}
+
+// NewMemoryStateStore returns a StateStore keeping the state in memory.
+// It survives restarts of a cron within the process, not of the process itself.
+func NewMemoryStateStore() StateStore {
+	return &memoryStateStore{
+		lastSuccess: make(map[string]time.Time),
+	}
+}
+
+type memoryStateStore struct {
+	mux         sync.Mutex
+	lastSuccess map[string]time.Time
+}
+
+func (m *memoryStateStore) LastSuccess(ctx context.Context, name string) (time.Time, error) {
+	m.mux.Lock()
+	defer m.mux.Unlock()
+	return m.lastSuccess[name], nil
+}
+
+func (m *memoryStateStore) SetLastSuccess(ctx context.Context, name string, scheduledTime time.Time) error {
+	m.mux.Lock()
+	defer m.mux.Unlock()
+	m.lastSuccess[name] = scheduledTime
+	return nil
+}
+
+// NewFileStateStore returns a StateStore keeping the state of all crons in a single JSON file.
+// The file is created on the first write and replaced atomically on every update.
+func NewFileStateStore(path string) StateStore {
+	return &fileStateStore{
+		path: path,
+	}
+}
+
+type fileStateStore struct {
+	mux  sync.Mutex
+	path string
+}
+
+func (f *fileStateStore) LastSuccess(ctx context.Context, name string) (time.Time, error) {
+	f.mux.Lock()
+	defer f.mux.Unlock()
+	lastSuccess, err := f.read(ctx)
+	if err != nil {
+		return time.Time{}, errors.Wrap(ctx, err, "read state failed")
+	}
+	return lastSuccess[name], nil
+}
+
+func (f *fileStateStore) SetLastSuccess(ctx context.Context, name string, scheduledTime time.Time) error {
+	f.mux.Lock()
+	defer f.mux.Unlock()
+	lastSuccess, err := f.read(ctx)
+	if err != nil {
+		return errors.Wrap(ctx, err, "read state failed")
+	}
+	lastSuccess[name] = scheduledTime
+	if err := f.write(ctx, lastSuccess); err != nil {
+		return errors.Wrap(ctx, err, "write state failed")
+	}
+	return nil
+}
+
+func (f *fileStateStore) read(ctx context.Context) (map[string]time.Time, error) {
+	content, err := os.ReadFile(f.path)
+	if os.IsNotExist(err) {
+		return make(map[string]time.Time), nil
+	}
+	if err != nil {
+		return nil, errors.Wrapf(ctx, err, "read file '%s' failed", f.path)
+	}
+	lastSuccess := make(map[string]time.Time)
+	if err := json.Unmarshal(content, &lastSuccess); err != nil {
+		return nil, errors.Wrapf(ctx, err, "unmarshal file '%s' failed", f.path)
+	}
+	return lastSuccess, nil
+}
+
+func (f *fileStateStore) write(ctx context.Context, lastSuccess map[string]time.Time) error {
+	content, err := json.MarshalIndent(lastSuccess, "", "  ")
+	if err != nil {
+		return errors.Wrap(ctx, err, "marshal state failed")
+	}
+	file, err := os.CreateTemp(filepath.Dir(f.path), filepath.Base(f.path)+".*.tmp")
+	if err != nil {
+		return errors.Wrapf(ctx, err, "create temp file for '%s' failed", f.path)
+	}
+	defer os.Remove(file.Name()) //nolint:errcheck // removing is a no-op after the rename
+	if _, err := file.Write(content); err != nil {
+		_ = file.Close()
+		return errors.Wrapf(ctx, err, "write temp file for '%s' failed", f.path)
+	}
+	if err := file.Close(); err != nil {
+		return errors.Wrapf(ctx, err, "close temp file for '%s' failed", f.path)
+	}
+	if err := os.Rename(file.Name(), f.path); err != nil {
+		return errors.Wrapf(ctx, err, "rename temp file to '%s' failed", f.path)
+	}
+	return nil
+}
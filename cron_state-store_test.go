@@ -0,0 +1,76 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package cron_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/bborbe/cron"
+)
+
+var _ = Describe("StateStore", func() {
+	var ctx context.Context
+	var scheduledTime time.Time
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		scheduledTime = time.Date(2026, 1, 1, 2, 0, 0, 0, time.UTC)
+	})
+
+	// behavesLikeStateStore runs the specs every StateStore implementation must pass.
+	behavesLikeStateStore := func(newStateStore func() cron.StateStore) {
+		It("returns zero for unknown names", func() {
+			lastSuccess, err := newStateStore().LastSuccess(ctx, "unknown")
+			Expect(err).To(BeNil())
+			Expect(lastSuccess).To(BeZero())
+		})
+		It("returns the recorded time", func() {
+			stateStore := newStateStore()
+			Expect(stateStore.SetLastSuccess(ctx, "job", scheduledTime)).To(Succeed())
+			lastSuccess, err := stateStore.LastSuccess(ctx, "job")
+			Expect(err).To(BeNil())
+			Expect(lastSuccess).To(BeTemporally("==", scheduledTime))
+		})
+		It("keeps names apart", func() {
+			stateStore := newStateStore()
+			Expect(stateStore.SetLastSuccess(ctx, "a", scheduledTime)).To(Succeed())
+			Expect(stateStore.SetLastSuccess(ctx, "b", scheduledTime.Add(time.Hour))).To(Succeed())
+			lastSuccess, err := stateStore.LastSuccess(ctx, "a")
+			Expect(err).To(BeNil())
+			Expect(lastSuccess).To(BeTemporally("==", scheduledTime))
+		})
+	}
+
+	Describe("NewMemoryStateStore", func() {
+		behavesLikeStateStore(cron.NewMemoryStateStore)
+	})
+
+	Describe("NewFileStateStore", func() {
+		var path string
+		BeforeEach(func() {
+			path = filepath.Join(GinkgoT().TempDir(), "state.json")
+		})
+		behavesLikeStateStore(func() cron.StateStore {
+			return cron.NewFileStateStore(path)
+		})
+		It("keeps the state across instances", func() {
+			Expect(cron.NewFileStateStore(path).SetLastSuccess(ctx, "job", scheduledTime)).To(Succeed())
+			lastSuccess, err := cron.NewFileStateStore(path).LastSuccess(ctx, "job")
+			Expect(err).To(BeNil())
+			Expect(lastSuccess).To(BeTemporally("==", scheduledTime))
+		})
+		It("fails on a corrupt file", func() {
+			Expect(os.WriteFile(path, []byte("banana"), 0600)).To(Succeed())
+			_, err := cron.NewFileStateStore(path).LastSuccess(ctx, "job")
+			Expect(err).NotTo(BeNil())
+		})
+	})
+})
@@ -0,0 +1,56 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package cron
+
+import (
+	"context"
+	"time"
+
+	"github.com/bborbe/errors"
+	"github.com/bborbe/run"
+)
+
+const (
+	// heartbeatStartTimeout limits how long the start ping including retries may delay the execution.
+	heartbeatStartTimeout = 5 * time.Second
+	// heartbeatCompletionTimeout limits the completion ping, which ignores the cancellation of the execution.
+	heartbeatCompletionTimeout = 30 * time.Second
+)
+
+// WrapWithHeartbeat wraps a runnable and pings the start, success or failure of every
+// execution, so an external watchdog alerts when the job stops succeeding.
+// Failed pings are logged and do not fail the execution. The start ping delays the execution
+// by at most 5 seconds, the completion ping is given up after 30 seconds.
+func WrapWithHeartbeat(name string, pinger Pinger, fn run.Runnable) run.Runnable {
+	return run.Func(func(ctx context.Context) (err error) {
+		logger := jobLogger(ctx, name)
+		startCtx, cancelStart := context.WithTimeout(ctx, heartbeatStartTimeout)
+		if pingErr := pinger.PingStart(startCtx); pingErr != nil {
+			logger.WarnContext(ctx, "ping start failed", LogKeyError, pingErr)
+		}
+		cancelStart()
+		completed := false
+		defer func() {
+			// the execution context may be done already, the ping must still be delivered
+			pingCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), heartbeatCompletionTimeout)
+			defer cancel()
+			var pingErr error
+			switch {
+			case !completed:
+				pingErr = pinger.PingFail(pingCtx, errors.Errorf(pingCtx, "cron '%s' panicked", name))
+			case err != nil:
+				pingErr = pinger.PingFail(pingCtx, err)
+			default:
+				pingErr = pinger.PingSuccess(pingCtx)
+			}
+			if pingErr != nil {
+				logger.WarnContext(ctx, "ping completion failed", LogKeyError, pingErr)
+			}
+		}()
+		err = fn.Run(ctx)
+		completed = true
+		return err
+	})
+}
@@ -0,0 +1,118 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package cron_test
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/bborbe/run"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/bborbe/cron"
+	"github.com/bborbe/cron/mocks"
+)
+
+var _ = Describe("WrapWithHeartbeat", func() {
+	var ctx context.Context
+	var pinger *mocks.CronPinger
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		pinger = &mocks.CronPinger{}
+	})
+
+	It("pings start and success", func() {
+		fn := cron.WrapWithHeartbeat("heartbeat-job", pinger, run.Func(func(ctx context.Context) error {
+			Expect(pinger.PingStartCallCount()).To(Equal(1))
+			return nil
+		}))
+		Expect(fn.Run(ctx)).To(Succeed())
+
+		Expect(pinger.PingStartCallCount()).To(Equal(1))
+		Expect(pinger.PingSuccessCallCount()).To(Equal(1))
+		Expect(pinger.PingFailCallCount()).To(Equal(0))
+	})
+
+	It("pings fail with the error", func() {
+		fn := cron.WrapWithHeartbeat("heartbeat-job", pinger, run.Func(func(ctx context.Context) error {
+			return errors.New("banana")
+		}))
+		Expect(fn.Run(ctx)).To(MatchError("banana"))
+
+		Expect(pinger.PingSuccessCallCount()).To(Equal(0))
+		Expect(pinger.PingFailCallCount()).To(Equal(1))
+		_, err := pinger.PingFailArgsForCall(0)
+		Expect(err).To(MatchError("banana"))
+	})
+
+	It("pings fail on panic", func() {
+		fn := cron.WrapWithHeartbeat("heartbeat-job", pinger, run.Func(func(ctx context.Context) error {
+			panic("banana")
+		}))
+		Expect(func() {
+			_ = fn.Run(ctx)
+		}).To(PanicWith("banana"))
+		Expect(pinger.PingFailCallCount()).To(Equal(1))
+	})
+
+	It("does not fail the execution if pings fail", func() {
+		pinger.PingStartReturns(errors.New("ping start failed"))
+		pinger.PingSuccessReturns(errors.New("ping success failed"))
+		fn := cron.WrapWithHeartbeat("heartbeat-job", pinger, run.Func(func(ctx context.Context) error {
+			return nil
+		}))
+		Expect(fn.Run(ctx)).To(Succeed())
+	})
+
+	It("pings completion with a context that is not cancelled but has a deadline", func() {
+		ctx, cancel := context.WithCancel(ctx)
+		var pingErr error
+		var pingDeadline bool
+		pinger.PingFailStub = func(ctx context.Context, err error) error {
+			pingErr = ctx.Err()
+			_, pingDeadline = ctx.Deadline()
+			return nil
+		}
+		fn := cron.WrapWithHeartbeat("heartbeat-job", pinger, run.Func(func(ctx context.Context) error {
+			cancel()
+			return ctx.Err()
+		}))
+		Expect(fn.Run(ctx)).NotTo(Succeed())
+		Expect(pinger.PingFailCallCount()).To(Equal(1))
+		Expect(pingErr).To(BeNil())
+		Expect(pingDeadline).To(BeTrue())
+	})
+
+	It("limits how long the start ping delays the execution", func() {
+		var deadline time.Time
+		pinger.PingStartStub = func(ctx context.Context) error {
+			deadline, _ = ctx.Deadline()
+			return nil
+		}
+		fn := cron.WrapWithHeartbeat("heartbeat-job", pinger, run.Func(func(ctx context.Context) error {
+			return nil
+		}))
+		Expect(fn.Run(ctx)).To(Succeed())
+		Expect(deadline).To(BeTemporally("~", time.Now().Add(5*time.Second), time.Second))
+	})
+
+	It("is applied via Options.Heartbeat", func() {
+		b := cron.NewOneTimeCronWithOptions(
+			run.Func(func(ctx context.Context) error {
+				return nil
+			}),
+			cron.Options{
+				Name:      "heartbeat-job",
+				Heartbeat: pinger,
+			},
+		)
+		Expect(b.Run(ctx)).To(Succeed())
+		Expect(pinger.PingStartCallCount()).To(Equal(1))
+		Expect(pinger.PingSuccessCallCount()).To(Equal(1))
+	})
+})
@@ -0,0 +1,76 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package cron
+
+import (
+	"context"
+	"sync/atomic"
+	"time"
+
+	"github.com/bborbe/run"
+)
+
+type contextKey string
+
+const (
+	scheduledTimeContextKey contextKey = "scheduledTime"
+	catchUpContextKey       contextKey = "catchUp"
+	jitterContextKey        contextKey = "jitter"
+	skippedContextKey       contextKey = "skipped"
+	sharedTicksContextKey   contextKey = "sharedTicks"
+)
+
+// WrapWithHistory wraps a runnable and records every execution in the given history.
+// It measures start and duration like WrapWithMetrics, the attempt number is reported
+// by WrapWithRetry if it runs inside.
+func WrapWithHistory(name string, history History, fn run.Runnable) run.Runnable {
+	return wrapWithHistory(name, history, NewClock(), fn)
+}
+
+// wrapWithHistory works like WrapWithHistory and reads start and duration from the given clock.
+func wrapWithHistory(name string, history History, clock Clock, fn run.Runnable) run.Runnable {
+	return run.Func(func(ctx context.Context) error {
+		ctx = withExecution(ctx, name, clock)
+		start := clock.Now()
+		err := fn.Run(ctx)
+		executionInfo, _ := ExecutionFromContext(ctx)
+		execution := Execution{
+			ScheduledTime: scheduledTimeFromContext(ctx),
+			Start:         start,
+			Duration:      clock.Now().Sub(start),
+			Attempt:       executionInfo.Attempt,
+		}
+		if err != nil {
+			execution.Error = err.Error()
+		}
+		history.Add(execution)
+		return err
+	})
+}
+
+// recordSkipped marks the execution as skipped and adds it to the history, if any.
+func recordSkipped(ctx context.Context, history History, clock Clock) {
+	if skipped, ok := ctx.Value(skippedContextKey).(*atomic.Bool); ok {
+		skipped.Store(true)
+	}
+	if history == nil {
+		return
+	}
+	history.Add(Execution{
+		ScheduledTime: scheduledTimeFromContext(ctx),
+		Start:         clock.Now(),
+		Skipped:       true,
+	})
+}
+
+// withScheduledTime returns a context carrying the time the execution was scheduled for.
+func withScheduledTime(ctx context.Context, scheduledTime time.Time) context.Context {
+	return context.WithValue(ctx, scheduledTimeContextKey, scheduledTime)
+}
+
+func scheduledTimeFromContext(ctx context.Context) time.Time {
+	scheduledTime, _ := ctx.Value(scheduledTimeContextKey).(time.Time)
+	return scheduledTime
+}
@@ -0,0 +1,167 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package cron_test
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/bborbe/run"
+	libtime "github.com/bborbe/time"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/bborbe/cron"
+	"github.com/bborbe/cron/mocks"
+)
+
+var _ = Describe("WrapWithHistory", func() {
+	var ctx context.Context
+	var history cron.History
+	var actionErr error
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		history = cron.NewHistory(10)
+		actionErr = nil
+	})
+	JustBeforeEach(func() {
+		fn := cron.WrapWithHistory("history-job", history, run.Func(func(ctx context.Context) error {
+			return actionErr
+		}))
+		_ = fn.Run(ctx)
+	})
+	Context("success", func() {
+		It("records the execution", func() {
+			executions := history.Executions()
+			Expect(executions).To(HaveLen(1))
+			Expect(executions[0].Start).NotTo(BeZero())
+			Expect(executions[0].Duration).To(BeNumerically(">=", 0))
+			Expect(executions[0].Error).To(BeEmpty())
+			Expect(executions[0].Attempt).To(Equal(1))
+			Expect(executions[0].Skipped).To(BeFalse())
+		})
+	})
+	Context("failure", func() {
+		BeforeEach(func() {
+			actionErr = errors.New("banana")
+		})
+		It("records the error message", func() {
+			executions := history.Executions()
+			Expect(executions).To(HaveLen(1))
+			Expect(executions[0].Error).To(Equal("banana"))
+		})
+	})
+})
+
+var _ = Describe("Options.History", func() {
+	var ctx context.Context
+	var cancel context.CancelFunc
+	var history cron.History
+
+	BeforeEach(func() {
+		ctx, cancel = context.WithCancel(context.Background())
+		history = cron.NewHistory(10)
+	})
+	AfterEach(func() {
+		cancel()
+	})
+	It("records the number of attempts", func() {
+		var calls int
+		err := cron.WrapWithOptions(run.Func(func(ctx context.Context) error {
+			calls++
+			if calls < 3 {
+				return errors.New("banana")
+			}
+			return nil
+		}), cron.Options{
+			Name:    "history-retry-job",
+			History: history,
+			Retry: cron.RetryOptions{
+				MaxAttempts:    3,
+				InitialBackoff: libtime.Millisecond,
+			},
+		}).Run(ctx)
+		Expect(err).To(BeNil())
+		executions := history.Executions()
+		Expect(executions).To(HaveLen(1))
+		Expect(executions[0].Attempt).To(Equal(3))
+	})
+	It("records skipped executions", func() {
+		started := make(chan struct{})
+		release := make(chan struct{})
+		fn := cron.WrapWithOptions(run.Func(func(ctx context.Context) error {
+			close(started)
+			<-release
+			return nil
+		}), cron.Options{
+			Name:          "history-skip-job",
+			History:       history,
+			OverlapPolicy: cron.OverlapPolicySkip,
+		})
+		done := make(chan error, 1)
+		go func() {
+			done <- fn.Run(ctx)
+		}()
+		Eventually(started).Should(BeClosed())
+		Expect(fn.Run(ctx)).To(Succeed())
+		close(release)
+		Eventually(done).Should(Receive(BeNil()))
+
+		executions := history.Executions()
+		Expect(executions).To(HaveLen(2))
+		Expect(executions[0].Skipped).To(BeTrue())
+		Expect(executions[1].Skipped).To(BeFalse())
+	})
+	It("records the scheduled time", func() {
+		clock := cron.NewFakeClock(time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC))
+		b := cron.NewExpressionCronWithOptions("0 * * * * ?", run.Func(func(ctx context.Context) error {
+			return nil
+		}), cron.Options{
+			Name:    "history-scheduled-job",
+			History: history,
+			Clock:   clock,
+		})
+		go func() {
+			_ = b.Run(ctx)
+		}()
+		Expect(clock.WaitForTimers(ctx, 1)).To(Succeed())
+		clock.Add(time.Minute)
+		Eventually(history.Executions).Should(HaveLen(1))
+		Expect(history.Executions()[0].ScheduledTime).To(
+			BeTemporally("==", time.Date(2026, 1, 1, 12, 1, 0, 0, time.UTC)),
+		)
+	})
+})
+
+var _ = Describe("Options.Clock", func() {
+	It("measures history and listener with the clock of the options", func() {
+		ctx := context.Background()
+		clock := cron.NewFakeClock(time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC))
+		history := cron.NewHistory(10)
+		listener := &mocks.CronListener{}
+		err := cron.WrapWithOptions(run.Func(func(ctx context.Context) error {
+			clock.Add(time.Minute)
+			return nil
+		}), cron.Options{
+			Name:     "history-clock-job",
+			History:  history,
+			Listener: listener,
+			Clock:    clock,
+		}).Run(ctx)
+		Expect(err).To(BeNil())
+		executions := history.Executions()
+		Expect(executions).To(HaveLen(1))
+		Expect(executions[0].Start).To(BeTemporally("==", time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)))
+		Expect(executions[0].Duration).To(Equal(time.Minute))
+		Expect(listener.OnStartCallCount()).To(Equal(1))
+		_, executionInfo := listener.OnStartArgsForCall(0)
+		Expect(executionInfo.Start).To(BeTemporally("==", time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)))
+		Expect(listener.OnSuccessCallCount()).To(Equal(1))
+		_, _, duration := listener.OnSuccessArgsForCall(0)
+		Expect(duration).To(Equal(time.Minute))
+	})
+})
@@ -0,0 +1,183 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package cron
+
+import (
+	"context"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/bborbe/errors"
+	"github.com/bborbe/run"
+	libtime "github.com/bborbe/time"
+)
+
+// DefaultLockTTL is the validity of a lock if Options.LockTTL is not set.
+const DefaultLockTTL = libtime.Minute
+
+// WrapWithLock wraps a runnable so only the replica acquiring the lock named after the job
+// runs an execution. The others skip it, counted as cron_job_lock_skipped_total.
+// Executions of expression crons lock <name>@<unix scheduled time>, since their ticks are the same
+// on all replicas, and keep the lock until ttl after the execution, so replicas starting the same
+// tick late skip it as well. Other executions, e.g. of interval crons whose ticks depend on the start
+// of each replica, lock the name and release it when done.
+// The lock is valid for ttl and refreshed every half ttl while the execution runs.
+// If a refresh fails, the execution is cancelled, since another replica may take over.
+// A ttl of 0 uses DefaultLockTTL.
+func WrapWithLock(name string, locker Locker, ttl libtime.Duration, fn run.Runnable) run.Runnable {
+	return WrapWithLockAndMetrics(name, locker, ttl, NewMetrics(), fn)
+}
+
+// WrapWithLockAndMetrics works like WrapWithLock but counts skipped executions in the given metrics,
+// e.g. created by NewMetricsWithRegisterer. Metrics not implementing LockMetrics ignore them.
+func WrapWithLockAndMetrics(
+	name string,
+	locker Locker,
+	ttl libtime.Duration,
+	metrics Metrics,
+	fn run.Runnable,
+) run.Runnable {
+	return wrapWithLock(name, locker, ttl, NewClock(), extendMetrics(metrics), nil, NopListener{}, fn)
+}
+
+// wrapWithLock works like WrapWithLock, counts in the given metrics,
+// records skipped executions in the history, if any, and reports them to the listener.
+func wrapWithLock(
+	name string,
+	locker Locker,
+	ttl libtime.Duration,
+	clock Clock,
+	metrics ExtendedMetrics,
+	history History,
+	listener Listener,
+	fn run.Runnable,
+) run.Runnable {
+	if ttl <= 0 {
+		ttl = DefaultLockTTL
+	}
+	return run.Func(func(ctx context.Context) error {
+		logger := jobLogger(ctx, name)
+		scheduledTime := scheduledTimeFromContext(ctx)
+		ticks, sharedTick := sharedTicksFromContext(ctx)
+		sharedTick = sharedTick && !scheduledTime.IsZero()
+		lockName := name
+		if sharedTick {
+			lockName = name + "@" + strconv.FormatInt(scheduledTime.Unix(), 10)
+		}
+		// every acquisition has its own owner, also of replicas sharing the locker
+		owner := newRunID()
+		acquired, err := locker.Acquire(ctx, lockName, owner, ttl.Duration())
+		if err != nil {
+			return errors.Wrapf(ctx, err, "acquire lock of cron '%s' failed", name)
+		}
+		if !acquired {
+			logger.InfoContext(ctx, "lock is held elsewhere, skip execution")
+			metrics.IncreaseLockSkipped(name)
+			recordSkipped(ctx, history, clock)
+			listener.OnSkip(ctx, currentExecution(ctx, name, clock), SkipReasonLocked)
+			return nil
+		}
+		expiry := clock.Now().Add(ttl.Duration())
+		release := func() {
+			// release even if the execution context is done, so others need not wait for the ttl
+			if err := locker.Release(context.WithoutCancel(ctx), lockName, owner); err != nil {
+				logger.WarnContext(ctx, "release lock failed", LogKeyError, err)
+			}
+		}
+
+		runCtx, cancel := context.WithCancel(ctx)
+		defer cancel()
+		refreshDone := make(chan struct{})
+		go func() {
+			defer close(refreshDone)
+			for {
+				timer := clock.NewTimer(ttl.Duration() / 2)
+				select {
+				case <-runCtx.Done():
+					timer.Stop()
+					return
+				case <-timer.C():
+				}
+				if err := locker.Refresh(runCtx, lockName, owner, ttl.Duration()); err != nil {
+					if runCtx.Err() != nil {
+						return
+					}
+					logger.WarnContext(ctx, "refresh lock failed, cancel execution", LogKeyError, err)
+					cancel()
+					return
+				}
+				expiry = clock.Now().Add(ttl.Duration())
+			}
+		}()
+		err = fn.Run(runCtx)
+		cancel()
+		<-refreshDone
+		if !sharedTick {
+			release()
+			return err
+		}
+		ticks.releaseAt(clock, expiry, release)
+		return err
+	})
+}
+
+// sharedTicks keeps the locks of executions whose ticks are the same on all replicas
+// until their ttl passed. The expression cron adds it to the context of its executions.
+type sharedTicks struct {
+	mux    sync.Mutex
+	wg     sync.WaitGroup
+	stop   chan struct{}
+	closed bool
+}
+
+func newSharedTicks() *sharedTicks {
+	return &sharedTicks{
+		stop: make(chan struct{}),
+	}
+}
+
+// withSharedTicks returns a context marking the scheduled times of executions as shared by all replicas.
+func withSharedTicks(ctx context.Context, ticks *sharedTicks) context.Context {
+	return context.WithValue(ctx, sharedTicksContextKey, ticks)
+}
+
+func sharedTicksFromContext(ctx context.Context) (*sharedTicks, bool) {
+	ticks, ok := ctx.Value(sharedTicksContextKey).(*sharedTicks)
+	return ticks, ok
+}
+
+// releaseAt calls release in the background once the clock reaches expiry or close is called.
+// It does not depend on the context of the execution, which OverlapPolicyReplace cancels early.
+func (s *sharedTicks) releaseAt(clock Clock, expiry time.Time, release func()) {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	if s.closed {
+		release()
+		return
+	}
+	timer := clock.NewTimer(expiry.Sub(clock.Now()))
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
+		defer timer.Stop()
+		select {
+		case <-s.stop:
+		case <-timer.C():
+		}
+		release()
+	}()
+}
+
+// close releases the kept locks and waits until they are released.
+func (s *sharedTicks) close() {
+	s.mux.Lock()
+	if !s.closed {
+		s.closed = true
+		close(s.stop)
+	}
+	s.mux.Unlock()
+	s.wg.Wait()
+}
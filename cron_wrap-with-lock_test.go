@@ -0,0 +1,391 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package cron_test
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"time"
+
+	"github.com/bborbe/run"
+	libtime "github.com/bborbe/time"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/bborbe/cron"
+	"github.com/bborbe/cron/mocks"
+)
+
+var _ = Describe("WrapWithLock", func() {
+	var ctx context.Context
+	var locker *mocks.CronLocker
+	var executions atomic.Int64
+	var action run.Runnable
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		locker = &mocks.CronLocker{}
+		executions.Store(0)
+		action = run.Func(func(ctx context.Context) error {
+			executions.Add(1)
+			return nil
+		})
+	})
+
+	It("runs and releases the lock if acquired", func() {
+		locker.AcquireReturns(true, nil)
+		fn := cron.WrapWithLock("lock-job", locker, libtime.Minute, action)
+		Expect(fn.Run(ctx)).To(Succeed())
+
+		Expect(executions.Load()).To(Equal(int64(1)))
+		Expect(locker.AcquireCallCount()).To(Equal(1))
+		_, name, _, ttl := locker.AcquireArgsForCall(0)
+		Expect(name).To(Equal("lock-job"))
+		Expect(ttl).To(Equal(time.Minute))
+		Expect(locker.ReleaseCallCount()).To(Equal(1))
+		_, releaseName, releaseOwner := locker.ReleaseArgsForCall(0)
+		_, _, owner, _ := locker.AcquireArgsForCall(0)
+		Expect(releaseName).To(Equal("lock-job"))
+		Expect(releaseOwner).To(Equal(owner))
+	})
+
+	It("uses a new owner for every acquisition", func() {
+		locker.AcquireReturns(true, nil)
+		fn := cron.WrapWithLock("lock-job", locker, libtime.Minute, action)
+		Expect(fn.Run(ctx)).To(Succeed())
+		Expect(fn.Run(ctx)).To(Succeed())
+		_, _, owner1, _ := locker.AcquireArgsForCall(0)
+		_, _, owner2, _ := locker.AcquireArgsForCall(1)
+		Expect(owner1).NotTo(BeEmpty())
+		Expect(owner1).NotTo(Equal(owner2))
+	})
+
+	It("skips the execution if the lock is held elsewhere", func() {
+		locker.AcquireReturns(false, nil)
+		fn := cron.WrapWithLock("lock-job", locker, libtime.Minute, action)
+		Expect(fn.Run(ctx)).To(Succeed())
+
+		Expect(executions.Load()).To(Equal(int64(0)))
+		Expect(locker.ReleaseCallCount()).To(Equal(0))
+	})
+
+	It("returns the error if acquiring fails", func() {
+		locker.AcquireReturns(false, errors.New("banana"))
+		fn := cron.WrapWithLock("lock-job", locker, libtime.Minute, action)
+		Expect(fn.Run(ctx)).To(MatchError(ContainSubstring("banana")))
+		Expect(executions.Load()).To(Equal(int64(0)))
+	})
+
+	It("counts skipped executions in the given metrics", func() {
+		locker.AcquireReturns(false, nil)
+		metrics := &mocks.CronMetrics{}
+		fn := cron.WrapWithLockAndMetrics("lock-job", locker, libtime.Minute, metrics, action)
+		Expect(fn.Run(ctx)).To(Succeed())
+
+		Expect(executions.Load()).To(Equal(int64(0)))
+		Expect(metrics.IncreaseLockSkippedCallCount()).To(Equal(1))
+		Expect(metrics.IncreaseLockSkippedArgsForCall(0)).To(Equal("lock-job"))
+	})
+
+	It("uses DefaultLockTTL without ttl", func() {
+		locker.AcquireReturns(true, nil)
+		fn := cron.WrapWithLock("lock-job", locker, 0, action)
+		Expect(fn.Run(ctx)).To(Succeed())
+		_, _, _, ttl := locker.AcquireArgsForCall(0)
+		Expect(ttl).To(Equal(cron.DefaultLockTTL.Duration()))
+	})
+
+	Context("with options", func() {
+		var clock *cron.FakeClock
+		var metrics *mocks.CronMetrics
+		var listener *mocks.CronListener
+		var history cron.History
+		var options cron.Options
+
+		BeforeEach(func() {
+			clock = cron.NewFakeClock(time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC))
+			metrics = &mocks.CronMetrics{}
+			listener = &mocks.CronListener{}
+			history = cron.NewHistory(10)
+			options = cron.Options{
+				Name:          "lock-job",
+				Locker:        locker,
+				LockTTL:       libtime.Minute,
+				Clock:         clock,
+				EnableMetrics: true,
+				Metrics:       metrics,
+				Listener:      listener,
+				History:       history,
+			}
+		})
+
+		It("counts, records and reports skipped executions", func() {
+			locker.AcquireReturns(false, nil)
+			fn := cron.WrapWithOptions(action, options)
+			Expect(fn.Run(ctx)).To(Succeed())
+
+			Expect(executions.Load()).To(Equal(int64(0)))
+			Expect(metrics.IncreaseLockSkippedCallCount()).To(Equal(1))
+			Expect(metrics.IncreaseLockSkippedArgsForCall(0)).To(Equal("lock-job"))
+			Expect(history.Executions()).To(HaveLen(1))
+			Expect(history.Executions()[0].Skipped).To(BeTrue())
+			Expect(listener.OnSkipCallCount()).To(Equal(1))
+			_, _, reason := listener.OnSkipArgsForCall(0)
+			Expect(reason).To(Equal(cron.SkipReasonLocked))
+			Expect(listener.OnStartCallCount()).To(Equal(0))
+		})
+
+		It("refreshes the lock every half ttl", func() {
+			locker.AcquireReturns(true, nil)
+			release := make(chan struct{})
+			done := make(chan error, 1)
+			fn := cron.WrapWithOptions(run.Func(func(ctx context.Context) error {
+				<-release
+				return nil
+			}), options)
+			go func() {
+				done <- fn.Run(ctx)
+			}()
+
+			Expect(clock.WaitForTimers(ctx, 1)).To(Succeed())
+			clock.Add(30 * time.Second)
+			Eventually(locker.RefreshCallCount).Should(Equal(1))
+			_, name, owner, ttl := locker.RefreshArgsForCall(0)
+			Expect(name).To(Equal("lock-job"))
+			_, _, acquireOwner, _ := locker.AcquireArgsForCall(0)
+			Expect(owner).To(Equal(acquireOwner))
+			Expect(ttl).To(Equal(time.Minute))
+
+			close(release)
+			Eventually(done).Should(Receive(BeNil()))
+			Expect(locker.ReleaseCallCount()).To(Equal(1))
+		})
+
+		It("cancels the execution if the refresh fails", func() {
+			locker.AcquireReturns(true, nil)
+			locker.RefreshReturns(errors.New("lock lost"))
+			done := make(chan error, 1)
+			fn := cron.WrapWithOptions(run.Func(func(ctx context.Context) error {
+				<-ctx.Done()
+				return ctx.Err()
+			}), options)
+			go func() {
+				done <- fn.Run(ctx)
+			}()
+
+			Expect(clock.WaitForTimers(ctx, 1)).To(Succeed())
+			clock.Add(30 * time.Second)
+			Eventually(done).Should(Receive(MatchError(context.Canceled)))
+			Expect(locker.ReleaseCallCount()).To(Equal(1))
+		})
+
+		It("runs an execution only once across replicas sharing the locker", func() {
+			sharedLocker := cron.NewMemoryLocker(clock)
+			release := make(chan struct{})
+			started := make(chan struct{})
+			blocking := run.Func(func(ctx context.Context) error {
+				executions.Add(1)
+				close(started)
+				<-release
+				return nil
+			})
+			replicaOptions := cron.Options{
+				Name:          "lock-job",
+				Locker:        sharedLocker,
+				Clock:         clock,
+				EnableMetrics: true,
+				Metrics:       metrics,
+			}
+			replica1 := cron.WrapWithOptions(blocking, replicaOptions)
+			replica2 := cron.WrapWithOptions(blocking, replicaOptions)
+			done := make(chan error, 1)
+			go func() {
+				done <- replica1.Run(ctx)
+			}()
+			Eventually(started).Should(BeClosed())
+
+			Expect(replica2.Run(ctx)).To(Succeed())
+			close(release)
+			Eventually(done).Should(Receive(BeNil()))
+			Expect(executions.Load()).To(Equal(int64(1)))
+			Expect(metrics.IncreaseLockSkippedCallCount()).To(Equal(1))
+		})
+
+		It("skips a scheduled execution on replicas starting it after the first one finished", func() {
+			runCtx, cancel := context.WithCancel(ctx)
+			defer cancel()
+			sharedLocker := cron.NewMemoryLocker(clock)
+			newReplica := func(replicaClock cron.Clock) run.Runnable {
+				return cron.NewExpressionCronWithOptions("0 0 * * * ?", action, cron.Options{
+					Name:          "lock-job",
+					Locker:        sharedLocker,
+					Clock:         replicaClock,
+					EnableMetrics: true,
+					Metrics:       metrics,
+				})
+			}
+			replica1Clock := cron.NewFakeClock(time.Date(2026, 1, 1, 11, 59, 59, 0, time.UTC))
+			replica2Clock := cron.NewFakeClock(time.Date(2026, 1, 1, 11, 59, 59, 0, time.UTC))
+			go func() {
+				_ = newReplica(replica1Clock).Run(runCtx)
+			}()
+			go func() {
+				_ = newReplica(replica2Clock).Run(runCtx)
+			}()
+			Expect(replica1Clock.WaitForTimers(runCtx, 1)).To(Succeed())
+			Expect(replica2Clock.WaitForTimers(runCtx, 1)).To(Succeed())
+
+			replica1Clock.Add(time.Second)
+			// the execution completed, the lock release and the next run are waiting
+			Expect(replica1Clock.WaitForTimers(runCtx, 2)).To(Succeed())
+			Expect(executions.Load()).To(Equal(int64(1)))
+
+			replica2Clock.Add(time.Second + 5*time.Millisecond)
+			Eventually(metrics.IncreaseLockSkippedCallCount).Should(Equal(1))
+			Expect(executions.Load()).To(Equal(int64(1)))
+		})
+
+		It("locks scheduled executions until the ttl passed", func() {
+			runCtx, cancel := context.WithCancel(ctx)
+			defer cancel()
+			locker.AcquireReturns(true, nil)
+			b := cron.NewExpressionCronWithOptions("0 0 * * * ?", action, options)
+			go func() {
+				_ = b.Run(runCtx)
+			}()
+			Expect(clock.WaitForTimers(runCtx, 1)).To(Succeed())
+			clock.Add(time.Hour)
+			Expect(clock.WaitForTimers(runCtx, 2)).To(Succeed())
+			_, name, owner, _ := locker.AcquireArgsForCall(0)
+			Expect(name).To(Equal("lock-job@1767272400"))
+			Expect(locker.ReleaseCallCount()).To(Equal(0))
+
+			clock.Add(time.Minute)
+			Eventually(locker.ReleaseCallCount).Should(Equal(1))
+			_, releaseName, releaseOwner := locker.ReleaseArgsForCall(0)
+			Expect(releaseName).To(Equal(name))
+			Expect(releaseOwner).To(Equal(owner))
+		})
+
+		It("keeps the lock of a replaced execution until the ttl passed or the cron stops", func() {
+			runCtx, cancel := context.WithCancel(ctx)
+			defer cancel()
+			sharedLocker := cron.NewMemoryLocker(clock)
+			var finished atomic.Int64
+			b := cron.NewExpressionCronWithOptions(
+				"0 * * * * ?",
+				run.Func(func(ctx context.Context) error {
+					executions.Add(1)
+					<-ctx.Done()
+					finished.Add(1)
+					return nil
+				}),
+				cron.Options{
+					Name:          "lock-job",
+					Locker:        sharedLocker,
+					LockTTL:       10 * libtime.Minute,
+					OverlapPolicy: cron.OverlapPolicyReplace,
+					Clock:         clock,
+				},
+			)
+			done := make(chan error, 1)
+			go func() {
+				done <- b.Run(runCtx)
+			}()
+			Expect(clock.WaitForTimers(runCtx, 1)).To(Succeed())
+			clock.Add(time.Minute)
+			Eventually(executions.Load).Should(Equal(int64(1)))
+			// the next run and the lock refresh are waiting
+			Expect(clock.WaitForTimers(runCtx, 2)).To(Succeed())
+			clock.Add(time.Minute)
+			Eventually(finished.Load).Should(Equal(int64(1)))
+			Eventually(executions.Load).Should(Equal(int64(2)))
+
+			// lock of the replaced execution at 12:01
+			acquire := func() bool {
+				acquired, err := sharedLocker.Acquire(ctx, "lock-job@1767268860", "other", time.Minute)
+				Expect(err).To(BeNil())
+				return acquired
+			}
+			Consistently(acquire).Should(BeFalse())
+
+			cancel()
+			Eventually(done).Should(Receive())
+			Expect(acquire()).To(BeTrue())
+		})
+	})
+})
+
+var _ = Describe("WrapWithLock with interval crons", func() {
+	var ctx context.Context
+	var cancel context.CancelFunc
+	var sharedLocker cron.Locker
+	var metrics *mocks.CronMetrics
+	var executions atomic.Int64
+	var started chan struct{}
+	var release chan struct{}
+
+	newReplica := func(clock cron.Clock) run.Runnable {
+		return cron.NewIntervalCronWithOptions(
+			libtime.Hour,
+			run.Func(func(ctx context.Context) error {
+				executions.Add(1)
+				started <- struct{}{}
+				<-release
+				return nil
+			}),
+			cron.Options{
+				Name:          "interval-lock-job",
+				Locker:        sharedLocker,
+				Clock:         clock,
+				EnableMetrics: true,
+				Metrics:       metrics,
+			},
+		)
+	}
+
+	BeforeEach(func() {
+		ctx, cancel = context.WithCancel(context.Background())
+		sharedLocker = cron.NewMemoryLocker(cron.NewFakeClock(time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)))
+		metrics = &mocks.CronMetrics{}
+		executions.Store(0)
+		started = make(chan struct{}, 10)
+		release = make(chan struct{})
+	})
+	AfterEach(func() {
+		cancel()
+	})
+
+	It("locks by name across replicas started at different times", func() {
+		replica1Clock := cron.NewFakeClock(time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC))
+		replica2Clock := cron.NewFakeClock(time.Date(2026, 1, 1, 12, 0, 1, 0, time.UTC))
+		go func() {
+			_ = newReplica(replica1Clock).Run(ctx)
+		}()
+		Eventually(started).Should(Receive())
+
+		go func() {
+			_ = newReplica(replica2Clock).Run(ctx)
+		}()
+		Eventually(metrics.IncreaseLockSkippedCallCount).Should(Equal(1))
+		Expect(executions.Load()).To(Equal(int64(1)))
+
+		close(release)
+		// the lock is released when the execution completed
+		Eventually(func() bool {
+			acquired, err := sharedLocker.Acquire(ctx, "interval-lock-job", "probe", time.Minute)
+			Expect(err).To(BeNil())
+			if acquired {
+				Expect(sharedLocker.Release(ctx, "interval-lock-job", "probe")).To(Succeed())
+			}
+			return acquired
+		}).Should(BeTrue())
+		Expect(replica2Clock.WaitForTimers(ctx, 1)).To(Succeed())
+		replica2Clock.Add(time.Hour)
+		Eventually(started).Should(Receive())
+		Eventually(executions.Load).Should(Equal(int64(2)))
+	})
+})
@@ -12,27 +12,38 @@ import (
 )
 
 // WrapWithMetrics wraps a runnable with Prometheus metrics collection.
-// Records start, completion, failure counts and execution duration for the named job.
+// Records start, completion, failure counts, running executions, last success and failure
+// and execution duration for the named job.
 func WrapWithMetrics(name string, fn run.Runnable) run.Runnable {
-	metrics := NewMetrics()
+	return WrapWithCustomMetrics(name, NewMetrics(), fn)
+}
+
+// WrapWithCustomMetrics works like WrapWithMetrics but reports to the given metrics,
+// e.g. created by NewMetricsWithRegisterer.
+func WrapWithCustomMetrics(name string, metrics Metrics, fn run.Runnable) run.Runnable {
+	extendedMetrics := extendMetrics(metrics)
 	return run.Func(func(ctx context.Context) error {
 		// Both the start and the elapsed calculation must read the same
 		// clock. Converting only one of them silently mixes libtime's
 		// swappable clock with the real one and yields garbage durations
 		// under a fake clock.
 		start := libtime.Now()
-		metrics.IncreaseStarted(name)
+		extendedMetrics.IncreaseStarted(name)
+		extendedMetrics.IncreaseRunning(name)
+		defer extendedMetrics.DecreaseRunning(name)
 
 		err := fn.Run(ctx)
 		duration := libtime.Now().Sub(start)
-		metrics.ObserveDuration(name, duration.Seconds())
+		extendedMetrics.ObserveDuration(name, duration.Seconds())
+		extendedMetrics.SetLastDuration(name, duration.Seconds())
 
 		if err != nil {
-			metrics.IncreaseFailed(name)
+			extendedMetrics.IncreaseFailed(name)
+			extendedMetrics.SetLastFailureToCurrent(name)
 			return err
 		}
-		metrics.IncreaseCompleted(name)
-		metrics.SetLastSuccessToCurrent(name)
+		extendedMetrics.IncreaseCompleted(name)
+		extendedMetrics.SetLastSuccessToCurrent(name)
 		return nil
 	})
 }
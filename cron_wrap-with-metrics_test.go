@@ -16,6 +16,7 @@ import (
 	"github.com/prometheus/client_golang/prometheus"
 
 	"github.com/bborbe/cron"
+	"github.com/bborbe/cron/mocks"
 )
 
 var _ = Describe("WrapWithMetrics", func() {
@@ -82,6 +83,54 @@ var _ = Describe("WrapWithMetrics", func() {
 	})
 })
 
+var _ = Describe("WrapWithCustomMetrics", func() {
+	var ctx context.Context
+	var metrics *mocks.CronMetrics
+	var actionError error
+	var err error
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		metrics = &mocks.CronMetrics{}
+		actionError = nil
+	})
+
+	JustBeforeEach(func() {
+		err = cron.WrapWithCustomMetrics("custom-job", metrics, run.Func(func(ctx context.Context) error {
+			Expect(metrics.IncreaseRunningCallCount()).To(Equal(1))
+			Expect(metrics.DecreaseRunningCallCount()).To(Equal(0))
+			return actionError
+		})).Run(ctx)
+	})
+
+	It("tracks the running execution", func() {
+		Expect(err).To(BeNil())
+		Expect(metrics.IncreaseRunningArgsForCall(0)).To(Equal("custom-job"))
+		Expect(metrics.DecreaseRunningCallCount()).To(Equal(1))
+	})
+
+	It("records the last duration and success", func() {
+		Expect(metrics.SetLastDurationCallCount()).To(Equal(1))
+		Expect(metrics.IncreaseCompletedCallCount()).To(Equal(1))
+		Expect(metrics.SetLastSuccessToCurrentCallCount()).To(Equal(1))
+		Expect(metrics.SetLastFailureToCurrentCallCount()).To(Equal(0))
+	})
+
+	Context("failed execution", func() {
+		BeforeEach(func() {
+			actionError = errors.New("banana")
+		})
+
+		It("records the last failure", func() {
+			Expect(err).To(Equal(actionError))
+			Expect(metrics.IncreaseFailedCallCount()).To(Equal(1))
+			Expect(metrics.SetLastFailureToCurrentCallCount()).To(Equal(1))
+			Expect(metrics.SetLastSuccessToCurrentCallCount()).To(Equal(0))
+			Expect(metrics.DecreaseRunningCallCount()).To(Equal(1))
+		})
+	})
+})
+
 var _ = Describe("WrapWithMetrics duration clock", func() {
 	var ctx context.Context
 	var originalNow func() stdtime.Time
@@ -10,26 +10,124 @@ import (
 
 // WrapWithOptions applies all configured wrappers to an action based on the provided options.
 // Wrappers are applied in this order (innermost to outermost):
-// 1. Timeout wrapper (if timeout > 0)
-// 2. Metrics wrapper (if enabled)
-// 3. Parallel skip wrapper (if enabled)
+// 1. Recover wrapper (if enabled), so panics are handled like errors
+// 2. Timeout wrapper (if timeout > 0), applied to each attempt
+// 3. Retry wrapper (if max attempts > 1)
+// 4. Metrics wrapper (if enabled)
+// 5. History wrapper (if a history is set)
+// 6. Listener wrapper (if a listener is set), reporting start, success and failure
+// 7. Sentry wrapper (if a client is set), one event and check-in per execution
+// 8. Heartbeat wrapper (if a pinger is set), one ping per execution
+// 9. Tracing wrapper (if a tracer provider is set), one span per execution including retries
+// 10. Lock wrapper (if a locker is set), so executions of other replicas are skipped
+// 11. Misfire threshold wrapper, measuring the delay of scheduled executions when they start
+// 12. Overlap policy wrapper (if not allow)
+// 13. Logger wrapper (if a logger is set), so all wrappers log to it
 func WrapWithOptions(action run.Runnable, options Options) run.Runnable {
 	wrappedAction := action
 
-	// Apply timeout wrapper first (innermost)
+	// Apply recover wrapper first (innermost)
+	if options.RecoverPanics {
+		wrappedAction = wrapWithRecover(options.Name, options.enabledMetrics(), wrappedAction)
+	}
+
+	// Apply timeout wrapper
 	if options.Timeout.Duration() > 0 {
-		wrappedAction = WrapWithTimeout(options.Name, options.Timeout, wrappedAction)
+		wrappedAction = wrapWithTimeout(
+			options.Name,
+			options.Timeout,
+			options.clockOrDefault(),
+			options.enabledMetrics(),
+			options.listenerOrDefault(),
+			wrappedAction,
+		)
 	}
 
-	// Apply metrics wrapper (outermost)
+	// Apply retry wrapper around the timeout, so each attempt gets the full timeout
+	if options.Retry.MaxAttempts > 1 {
+		retryOptions := options.Retry
+		if retryOptions.Clock == nil {
+			retryOptions.Clock = options.Clock
+		}
+		if retryOptions.Metrics == nil {
+			retryOptions.Metrics = options.enabledMetrics()
+		}
+		wrappedAction = WrapWithRetry(options.Name, retryOptions, wrappedAction)
+	}
+
+	// Apply metrics wrapper
 	if options.EnableMetrics {
-		wrappedAction = WrapWithMetrics(options.Name, wrappedAction)
+		wrappedAction = WrapWithCustomMetrics(options.Name, options.enabledMetrics(), wrappedAction)
+	}
+
+	// Apply history wrapper next to metrics, so both see the same executions
+	if options.History != nil {
+		wrappedAction = wrapWithHistory(options.Name, options.History, options.clockOrDefault(), wrappedAction)
+	}
+
+	// Apply listener wrapper around retries, so each execution is reported once
+	if options.Listener != nil {
+		wrappedAction = wrapWithListener(options.Name, options.Listener, options.clockOrDefault(), wrappedAction)
+	}
+
+	// Apply sentry wrapper around retries, so each execution is captured once
+	if options.SentryClient != nil {
+		wrappedAction = wrapWithSentry(
+			options.SentryClient,
+			options.Name,
+			options.Location,
+			options.clockOrDefault(),
+			wrappedAction,
+		)
+	}
+
+	// Apply heartbeat wrapper around retries, so each execution is pinged once
+	if options.Heartbeat != nil {
+		wrappedAction = WrapWithHeartbeat(options.Name, options.Heartbeat, wrappedAction)
+	}
+
+	// Apply tracing wrapper around retries, so each execution is a single span
+	if options.TracerProvider != nil {
+		wrappedAction = WrapWithTracing(options.Name, options.TracerProvider, wrappedAction)
+	}
+
+	// Apply lock wrapper inside the overlap policy, so queued executions lock when they start
+	if options.Locker != nil {
+		wrappedAction = wrapWithLock(
+			options.Name,
+			options.Locker,
+			options.LockTTL,
+			options.clockOrDefault(),
+			options.enabledMetrics(),
+			options.History,
+			options.listenerOrDefault(),
+			wrappedAction,
+		)
+	}
+
+	// Apply misfire threshold wrapper inside the overlap policy, so queued executions count as late
+	wrappedAction = wrapWithMisfireThreshold(options, options.clockOrDefault(), wrappedAction)
+
+	// Apply overlap policy wrapper, ParallelSkip is a shortcut for OverlapPolicySkip
+	overlapPolicy := options.OverlapPolicy
+	if overlapPolicy == "" && options.ParallelSkip {
+		overlapPolicy = OverlapPolicySkip
+	}
+	if overlapPolicy != "" && overlapPolicy != OverlapPolicyAllow {
+		wrappedAction = wrapWithOverlapPolicy(
+			options.Name,
+			overlapPolicy,
+			options.clockOrDefault(),
+			options.enabledMetrics(),
+			options.History,
+			options.listenerOrDefault(),
+			wrappedAction,
+		)
 	}
 
-	// Apply parallel skip wrapper if enabled
-	if options.ParallelSkip {
-		parallelSkipper := run.NewParallelSkipper()
-		wrappedAction = parallelSkipper.SkipParallel(wrappedAction.Run)
+	// Apply logger wrapper last (outermost)
+	if options.Logger != nil {
+		wrappedAction = WrapWithLogger(options.Name, options.Logger, wrappedAction)
 	}
 
 	return wrappedAction
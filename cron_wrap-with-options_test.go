@@ -15,6 +15,7 @@ import (
 	. "github.com/onsi/gomega"
 
 	"github.com/bborbe/cron"
+	"github.com/bborbe/cron/mocks"
 )
 
 var _ = Describe("WrapWithOptions", func() {
@@ -85,6 +86,40 @@ var _ = Describe("WrapWithOptions", func() {
 				Expect(actionCalled).To(BeTrue())
 			})
 		})
+
+		It("reports to the metrics of the options", func() {
+			metrics := &mocks.CronMetrics{}
+			options := cron.Options{
+				Name:          "custom-metrics-job",
+				EnableMetrics: true,
+				Metrics:       metrics,
+			}
+
+			Expect(cron.WrapWithOptions(action, options).Run(ctx)).To(Succeed())
+
+			Expect(metrics.IncreaseStartedCallCount()).To(Equal(1))
+			Expect(metrics.IncreaseStartedArgsForCall(0)).To(Equal("custom-metrics-job"))
+			Expect(metrics.IncreaseCompletedCallCount()).To(Equal(1))
+		})
+
+		It("reports nothing without EnableMetrics", func() {
+			metrics := &mocks.CronMetrics{}
+			options := cron.Options{
+				Name:          "disabled-metrics-job",
+				Metrics:       metrics,
+				Timeout:       libtime.Nanosecond,
+				RecoverPanics: true,
+				OverlapPolicy: cron.OverlapPolicySkip,
+			}
+
+			err := cron.WrapWithOptions(run.Func(func(ctx context.Context) error {
+				<-ctx.Done()
+				return ctx.Err()
+			}), options).Run(ctx)
+
+			Expect(err).NotTo(BeNil())
+			Expect(metrics.Invocations()).To(BeEmpty())
+		})
 	})
 
 	Describe("with timeout enabled", func() {
@@ -248,4 +283,60 @@ var _ = Describe("WrapWithOptions", func() {
 			Expect(actionCalled).To(BeTrue())
 		})
 	})
+
+	Describe("with retry enabled", func() {
+		var calls int
+
+		BeforeEach(func() {
+			calls = 0
+			action = run.Func(func(ctx context.Context) error {
+				calls++
+				if calls < 2 {
+					return errors.New("transient")
+				}
+				return nil
+			})
+		})
+
+		It("retries the failed action", func() {
+			options := cron.Options{
+				Name: "retry-options-job",
+				Retry: cron.RetryOptions{
+					MaxAttempts:    2,
+					InitialBackoff: libtime.Millisecond,
+				},
+			}
+
+			err := cron.WrapWithOptions(action, options).Run(ctx)
+
+			Expect(err).To(BeNil())
+			Expect(calls).To(Equal(2))
+		})
+
+		It("applies the timeout per attempt", func() {
+			options := cron.Options{
+				Name:    "retry-timeout-job",
+				Timeout: 50 * libtime.Millisecond,
+				Retry: cron.RetryOptions{
+					MaxAttempts:    2,
+					InitialBackoff: 50 * libtime.Millisecond,
+				},
+			}
+			action = run.Func(func(ctx context.Context) error {
+				calls++
+				deadline, ok := ctx.Deadline()
+				Expect(ok).To(BeTrue())
+				Expect(time.Until(deadline)).To(BeNumerically(">", 10*time.Millisecond))
+				if calls < 2 {
+					return errors.New("transient")
+				}
+				return nil
+			})
+
+			err := cron.WrapWithOptions(action, options).Run(ctx)
+
+			Expect(err).To(BeNil())
+			Expect(calls).To(Equal(2))
+		})
+	})
 })
@@ -0,0 +1,192 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package cron
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+
+	"github.com/bborbe/run"
+)
+
+// OverlapPolicy decides what happens if an execution is due while the previous one is still running.
+// It mirrors the concurrencyPolicy of Kubernetes CronJobs.
+type OverlapPolicy string
+
+const (
+	// OverlapPolicyAllow runs overlapping executions concurrently. This is the default.
+	OverlapPolicyAllow OverlapPolicy = "allow"
+	// OverlapPolicySkip drops an execution if the previous one is still running.
+	OverlapPolicySkip OverlapPolicy = "skip"
+	// OverlapPolicyQueue runs an execution after the previous one finished.
+	// Further executions due while one is already queued are coalesced into it.
+	OverlapPolicyQueue OverlapPolicy = "queue"
+	// OverlapPolicyReplace cancels the context of the running execution
+	// and starts the new one once the previous returned.
+	OverlapPolicyReplace OverlapPolicy = "replace"
+)
+
+// String returns the overlap policy as a string.
+func (o OverlapPolicy) String() string {
+	return string(o)
+}
+
+// WrapWithOverlapPolicy wraps a runnable with the given overlap policy.
+// For OverlapPolicyAllow and unknown policies the original runnable is returned unchanged.
+// Skipped, queued and replaced executions are counted as cron_job_skipped_total, cron_job_queued_total
+// and cron_job_replaced_total.
+func WrapWithOverlapPolicy(name string, overlapPolicy OverlapPolicy, fn run.Runnable) run.Runnable {
+	return WrapWithOverlapPolicyAndMetrics(name, overlapPolicy, NewMetrics(), fn)
+}
+
+// WrapWithOverlapPolicyAndMetrics works like WrapWithOverlapPolicy but counts in the given metrics,
+// e.g. created by NewMetricsWithRegisterer. Metrics not implementing OverlapMetrics ignore them.
+func WrapWithOverlapPolicyAndMetrics(
+	name string,
+	overlapPolicy OverlapPolicy,
+	metrics Metrics,
+	fn run.Runnable,
+) run.Runnable {
+	return wrapWithOverlapPolicy(name, overlapPolicy, NewClock(), extendMetrics(metrics), nil, NopListener{}, fn)
+}
+
+// wrapWithOverlapPolicy works like WrapWithOverlapPolicy, counts in the given metrics,
+// records skipped executions in the history, if any, and reports them to the listener.
+func wrapWithOverlapPolicy(
+	name string,
+	overlapPolicy OverlapPolicy,
+	clock Clock,
+	metrics ExtendedMetrics,
+	history History,
+	listener Listener,
+	fn run.Runnable,
+) run.Runnable {
+	switch overlapPolicy {
+	case OverlapPolicySkip:
+		return wrapWithOverlapSkip(name, clock, metrics, history, listener, fn)
+	case OverlapPolicyQueue:
+		return wrapWithOverlapQueue(name, clock, metrics, history, listener, fn)
+	case OverlapPolicyReplace:
+		return wrapWithOverlapReplace(name, metrics, fn)
+	default:
+		jobLogger(context.Background(), name).Debug("overlapping executions are allowed")
+		return fn
+	}
+}
+
+func wrapWithOverlapSkip(
+	name string,
+	clock Clock,
+	metrics ExtendedMetrics,
+	history History,
+	listener Listener,
+	fn run.Runnable,
+) run.Runnable {
+	var running atomic.Bool
+	return run.Func(func(ctx context.Context) error {
+		if !running.CompareAndSwap(false, true) {
+			jobLogger(ctx, name).InfoContext(ctx, "cron is still running, skip execution")
+			metrics.IncreaseSkipped(name)
+			recordSkipped(ctx, history, clock)
+			listener.OnSkip(ctx, currentExecution(ctx, name, clock), SkipReasonOverlap)
+			return nil
+		}
+		defer running.Store(false)
+		return fn.Run(ctx)
+	})
+}
+
+func wrapWithOverlapQueue(
+	name string,
+	clock Clock,
+	metrics ExtendedMetrics,
+	history History,
+	listener Listener,
+	fn run.Runnable,
+) run.Runnable {
+	running := make(chan struct{}, 1)
+	var queued atomic.Bool
+	return run.Func(func(ctx context.Context) error {
+		select {
+		case running <- struct{}{}:
+		default:
+			if !queued.CompareAndSwap(false, true) {
+				jobLogger(ctx, name).InfoContext(ctx, "cron has a queued execution already, skip execution")
+				metrics.IncreaseSkipped(name)
+				recordSkipped(ctx, history, clock)
+				listener.OnSkip(ctx, currentExecution(ctx, name, clock), SkipReasonOverlap)
+				return nil
+			}
+			jobLogger(ctx, name).InfoContext(ctx, "cron is still running, queue execution")
+			metrics.IncreaseQueued(name)
+			select {
+			case <-ctx.Done():
+				queued.Store(false)
+				return nil
+			case running <- struct{}{}:
+				queued.Store(false)
+			}
+		}
+		defer func() {
+			<-running
+		}()
+		return fn.Run(ctx)
+	})
+}
+
+type overlapExecution struct {
+	cancel   context.CancelFunc
+	done     chan struct{}
+	replaced bool
+}
+
+func wrapWithOverlapReplace(name string, metrics ExtendedMetrics, fn run.Runnable) run.Runnable {
+	var mux sync.Mutex
+	var current *overlapExecution
+	return run.Func(func(ctx context.Context) error {
+		ctx, cancel := context.WithCancel(ctx)
+		execution := &overlapExecution{
+			cancel: cancel,
+			done:   make(chan struct{}),
+		}
+		defer func() {
+			cancel()
+			mux.Lock()
+			if current == execution {
+				current = nil
+			}
+			mux.Unlock()
+			close(execution.done)
+		}()
+
+		mux.Lock()
+		previous := current
+		current = execution
+		if previous != nil {
+			previous.replaced = true
+		}
+		mux.Unlock()
+
+		if previous != nil {
+			jobLogger(ctx, name).InfoContext(ctx, "cron is still running, replace execution")
+			metrics.IncreaseReplaced(name)
+			previous.cancel()
+			<-previous.done
+		}
+		if ctx.Err() != nil {
+			return nil
+		}
+
+		err := fn.Run(ctx)
+		mux.Lock()
+		defer mux.Unlock()
+		if execution.replaced {
+			jobLogger(ctx, name).InfoContext(ctx, "execution was replaced")
+			return nil
+		}
+		return err
+	})
+}
@@ -0,0 +1,227 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package cron_test
+
+import (
+	"context"
+	"sync/atomic"
+
+	"github.com/bborbe/run"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/bborbe/cron"
+	"github.com/bborbe/cron/mocks"
+)
+
+var _ = Describe("WrapWithOverlapPolicy", func() {
+	var ctx context.Context
+	var cancel context.CancelFunc
+	var overlapPolicy cron.OverlapPolicy
+	var name string
+	var started chan struct{}
+	var release chan struct{}
+	var calls int64
+	var cancelled int64
+	var fn run.Runnable
+
+	// runAsync starts fn in the background and returns the channel its result is sent to.
+	runAsync := func() <-chan error {
+		result := make(chan error, 1)
+		go func() {
+			result <- fn.Run(ctx)
+		}()
+		return result
+	}
+
+	BeforeEach(func() {
+		ctx, cancel = context.WithCancel(context.Background())
+		started = make(chan struct{}, 10)
+		release = make(chan struct{})
+		atomic.StoreInt64(&calls, 0)
+		atomic.StoreInt64(&cancelled, 0)
+	})
+	AfterEach(func() {
+		cancel()
+	})
+	JustBeforeEach(func() {
+		fn = cron.WrapWithOverlapPolicy(
+			name,
+			overlapPolicy,
+			run.Func(func(ctx context.Context) error {
+				atomic.AddInt64(&calls, 1)
+				started <- struct{}{}
+				select {
+				case <-ctx.Done():
+					atomic.AddInt64(&cancelled, 1)
+					return ctx.Err()
+				case <-release:
+					return nil
+				}
+			}),
+		)
+	})
+	Context("allow", func() {
+		BeforeEach(func() {
+			overlapPolicy = cron.OverlapPolicyAllow
+			name = "overlap-allow-job"
+		})
+		It("runs executions concurrently", func() {
+			first := runAsync()
+			second := runAsync()
+			Eventually(started).Should(Receive())
+			Eventually(started).Should(Receive())
+			close(release)
+			Eventually(first).Should(Receive(BeNil()))
+			Eventually(second).Should(Receive(BeNil()))
+			Expect(atomic.LoadInt64(&calls)).To(Equal(int64(2)))
+		})
+	})
+	Context("skip", func() {
+		BeforeEach(func() {
+			overlapPolicy = cron.OverlapPolicySkip
+			name = "overlap-skip-job"
+		})
+		It("drops the overlapping execution", func() {
+			first := runAsync()
+			Eventually(started).Should(Receive())
+			Expect(fn.Run(ctx)).To(BeNil())
+			close(release)
+			Eventually(first).Should(Receive(BeNil()))
+			Expect(atomic.LoadInt64(&calls)).To(Equal(int64(1)))
+			Expect(counterValue("cron_job_skipped_total", name)).To(BeNumerically(">=", 1))
+		})
+		It("runs again after the previous finished", func() {
+			close(release)
+			Expect(fn.Run(ctx)).To(BeNil())
+			Expect(fn.Run(ctx)).To(BeNil())
+			Expect(atomic.LoadInt64(&calls)).To(Equal(int64(2)))
+		})
+	})
+	Context("queue", func() {
+		BeforeEach(func() {
+			overlapPolicy = cron.OverlapPolicyQueue
+			name = "overlap-queue-job"
+		})
+		It("runs the overlapping execution afterwards", func() {
+			first := runAsync()
+			Eventually(started).Should(Receive())
+			second := runAsync()
+			Eventually(func() float64 {
+				return counterValue("cron_job_queued_total", name)
+			}).Should(BeNumerically(">=", 1))
+			Consistently(started).ShouldNot(Receive())
+
+			release <- struct{}{}
+			Eventually(first).Should(Receive(BeNil()))
+			Eventually(started).Should(Receive())
+			release <- struct{}{}
+			Eventually(second).Should(Receive(BeNil()))
+			Expect(atomic.LoadInt64(&calls)).To(Equal(int64(2)))
+		})
+		It("coalesces multiple pending executions", func() {
+			first := runAsync()
+			Eventually(started).Should(Receive())
+			second := runAsync()
+			Eventually(func() float64 {
+				return counterValue("cron_job_queued_total", name)
+			}).Should(BeNumerically(">=", 1))
+			Expect(fn.Run(ctx)).To(BeNil())
+
+			close(release)
+			Eventually(first).Should(Receive(BeNil()))
+			Eventually(second).Should(Receive(BeNil()))
+			Expect(atomic.LoadInt64(&calls)).To(Equal(int64(2)))
+		})
+	})
+	Context("replace", func() {
+		BeforeEach(func() {
+			overlapPolicy = cron.OverlapPolicyReplace
+			name = "overlap-replace-job"
+		})
+		It("cancels the running execution and starts the new one", func() {
+			first := runAsync()
+			Eventually(started).Should(Receive())
+			second := runAsync()
+			Eventually(first).Should(Receive(BeNil()))
+			Eventually(started).Should(Receive())
+			Expect(atomic.LoadInt64(&cancelled)).To(Equal(int64(1)))
+
+			close(release)
+			Eventually(second).Should(Receive(BeNil()))
+			Expect(atomic.LoadInt64(&calls)).To(Equal(int64(2)))
+			Expect(counterValue("cron_job_replaced_total", name)).To(BeNumerically(">=", 1))
+		})
+	})
+})
+
+var _ = Describe("WrapWithOverlapPolicyAndMetrics", func() {
+	var ctx context.Context
+	var cancel context.CancelFunc
+	var metrics *mocks.CronMetrics
+	var started chan struct{}
+	var release chan struct{}
+	var fn run.Runnable
+
+	BeforeEach(func() {
+		ctx, cancel = context.WithCancel(context.Background())
+		metrics = &mocks.CronMetrics{}
+		started = make(chan struct{}, 10)
+		release = make(chan struct{})
+		fn = cron.WrapWithOverlapPolicyAndMetrics(
+			"overlap-metrics-job",
+			cron.OverlapPolicySkip,
+			metrics,
+			run.Func(func(ctx context.Context) error {
+				started <- struct{}{}
+				<-release
+				return nil
+			}),
+		)
+	})
+	AfterEach(func() {
+		cancel()
+	})
+	It("counts the skipped execution in the given metrics", func() {
+		first := make(chan error, 1)
+		go func() {
+			first <- fn.Run(ctx)
+		}()
+		Eventually(started).Should(Receive())
+		Expect(fn.Run(ctx)).To(BeNil())
+		close(release)
+		Eventually(first).Should(Receive(BeNil()))
+		Expect(metrics.IncreaseSkippedCallCount()).To(Equal(1))
+		Expect(metrics.IncreaseSkippedArgsForCall(0)).To(Equal("overlap-metrics-job"))
+	})
+})
+
+var _ = Describe("WrapWithOptions with DefaultOptions", func() {
+	It("skips overlapping executions with ParallelSkip", func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		var calls int64
+		started := make(chan struct{})
+		release := make(chan struct{})
+		options := cron.DefaultOptions()
+		options.ParallelSkip = true
+		fn := cron.WrapWithOptions(run.Func(func(ctx context.Context) error {
+			atomic.AddInt64(&calls, 1)
+			close(started)
+			<-release
+			return nil
+		}), options)
+
+		first := make(chan error, 1)
+		go func() {
+			first <- fn.Run(ctx)
+		}()
+		Eventually(started).Should(BeClosed())
+		Expect(fn.Run(ctx)).To(BeNil())
+		close(release)
+		Eventually(first).Should(Receive(BeNil()))
+		Expect(atomic.LoadInt64(&calls)).To(Equal(int64(1)))
+	})
+})
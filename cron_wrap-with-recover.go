@@ -0,0 +1,66 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package cron
+
+import (
+	"context"
+	"fmt"
+	"runtime/debug"
+
+	"github.com/bborbe/run"
+)
+
+// PanicError is returned by WrapWithRecover if the wrapped action panicked.
+type PanicError struct {
+	// Name of the cron that panicked.
+	Name string
+	// Value passed to panic.
+	Value any
+	// Stack trace of the goroutine at the time of the panic.
+	Stack []byte
+}
+
+// Error returns the panic value followed by the stack trace.
+func (p *PanicError) Error() string {
+	return fmt.Sprintf("cron '%s' panicked: %v\n%s", p.Name, p.Value, p.Stack)
+}
+
+// Unwrap returns the panic value if it is an error.
+func (p *PanicError) Unwrap() error {
+	if err, ok := p.Value.(error); ok {
+		return err
+	}
+	return nil
+}
+
+// WrapWithRecover wraps a runnable with panic recovery.
+// A panic is returned as *PanicError carrying the stack trace and counted as cron_job_panics_total.
+func WrapWithRecover(name string, fn run.Runnable) run.Runnable {
+	return WrapWithRecoverAndMetrics(name, NewMetrics(), fn)
+}
+
+// WrapWithRecoverAndMetrics works like WrapWithRecover but counts panics in the given metrics,
+// e.g. created by NewMetricsWithRegisterer. Metrics not implementing PanicMetrics ignore them.
+func WrapWithRecoverAndMetrics(name string, metrics Metrics, fn run.Runnable) run.Runnable {
+	return wrapWithRecover(name, extendMetrics(metrics), fn)
+}
+
+// wrapWithRecover works like WrapWithRecover and counts panics in the given metrics.
+func wrapWithRecover(name string, metrics ExtendedMetrics, fn run.Runnable) run.Runnable {
+	return run.Func(func(ctx context.Context) (err error) {
+		defer func() {
+			if r := recover(); r != nil {
+				metrics.IncreasePanic(name)
+				err = &PanicError{
+					Name:  name,
+					Value: r,
+					Stack: debug.Stack(),
+				}
+				jobLogger(ctx, name).WarnContext(ctx, "recovered panic", "panic", r)
+			}
+		}()
+		return fn.Run(ctx)
+	})
+}
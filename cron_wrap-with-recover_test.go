@@ -0,0 +1,192 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package cron_test
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"time"
+
+	"github.com/bborbe/run"
+	libtime "github.com/bborbe/time"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/bborbe/cron"
+	"github.com/bborbe/cron/mocks"
+)
+
+var _ = Describe("WrapWithRecover", func() {
+	var ctx context.Context
+	var err error
+	var panicValue any
+	BeforeEach(func() {
+		ctx = context.Background()
+		panicValue = nil
+	})
+	JustBeforeEach(func() {
+		fn := cron.WrapWithRecover(
+			"recover-cron",
+			run.Func(func(ctx context.Context) error {
+				if panicValue != nil {
+					panic(panicValue)
+				}
+				return nil
+			}),
+		)
+		err = fn.Run(ctx)
+	})
+	Context("no panic", func() {
+		It("returns no error", func() {
+			Expect(err).To(BeNil())
+		})
+	})
+	Context("panic", func() {
+		BeforeEach(func() {
+			panicValue = "banana"
+		})
+		It("returns a PanicError", func() {
+			var panicErr *cron.PanicError
+			Expect(errors.As(err, &panicErr)).To(BeTrue())
+			Expect(panicErr.Name).To(Equal("recover-cron"))
+			Expect(panicErr.Value).To(Equal("banana"))
+		})
+		It("contains the stack trace", func() {
+			Expect(err.Error()).To(ContainSubstring("cron 'recover-cron' panicked: banana"))
+			Expect(err.Error()).To(ContainSubstring("cron_wrap-with-recover_test.go"))
+		})
+		It("counts the panic", func() {
+			Expect(counterValue("cron_job_panics_total", "recover-cron")).To(BeNumerically(">=", 1))
+		})
+	})
+	Context("panic with error", func() {
+		var panicErr error
+		BeforeEach(func() {
+			panicErr = errors.New("banana")
+			panicValue = panicErr
+		})
+		It("unwraps to the error", func() {
+			Expect(errors.Is(err, panicErr)).To(BeTrue())
+		})
+	})
+})
+
+var _ = Describe("WrapWithRecoverAndMetrics", func() {
+	var ctx context.Context
+	var metrics *mocks.CronMetrics
+	BeforeEach(func() {
+		ctx = context.Background()
+		metrics = &mocks.CronMetrics{}
+	})
+	It("counts the panic in the given metrics", func() {
+		err := cron.WrapWithRecoverAndMetrics(
+			"recover-metrics-cron",
+			metrics,
+			run.Func(func(ctx context.Context) error {
+				panic("banana")
+			}),
+		).Run(ctx)
+		var panicErr *cron.PanicError
+		Expect(errors.As(err, &panicErr)).To(BeTrue())
+		Expect(metrics.IncreasePanicCallCount()).To(Equal(1))
+		Expect(metrics.IncreasePanicArgsForCall(0)).To(Equal("recover-metrics-cron"))
+	})
+	It("does not count without a panic", func() {
+		err := cron.WrapWithRecoverAndMetrics(
+			"recover-metrics-cron",
+			metrics,
+			run.Func(func(ctx context.Context) error {
+				return nil
+			}),
+		).Run(ctx)
+		Expect(err).To(BeNil())
+		Expect(metrics.IncreasePanicCallCount()).To(Equal(0))
+	})
+})
+
+var _ = Describe("RecoverPanics", func() {
+	var ctx context.Context
+	var cancel context.CancelFunc
+	var clock *cron.FakeClock
+	var calls int64
+
+	BeforeEach(func() {
+		ctx, cancel = context.WithCancel(context.Background())
+		clock = cron.NewFakeClock(time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC))
+		atomic.StoreInt64(&calls, 0)
+	})
+	AfterEach(func() {
+		cancel()
+	})
+	It("keeps an expression cron running with continue policy", func() {
+		b := cron.NewExpressionCronWithOptions(
+			"0 * * * * ?",
+			run.Func(func(ctx context.Context) error {
+				atomic.AddInt64(&calls, 1)
+				panic("banana")
+			}),
+			cron.Options{
+				Name:          "recover-expression-job",
+				RecoverPanics: true,
+				ErrorPolicy:   cron.ErrorPolicyContinue,
+				Clock:         clock,
+			},
+		)
+		result := make(chan error, 1)
+		go func(ctx context.Context, result chan<- error) {
+			result <- b.Run(ctx)
+		}(ctx, result)
+		for i := 0; i < 2; i++ {
+			Expect(clock.WaitForTimers(ctx, 1)).To(Succeed())
+			clock.Add(time.Minute)
+		}
+		Eventually(func() int64 { return atomic.LoadInt64(&calls) }).Should(Equal(int64(2)))
+		Consistently(result).ShouldNot(Receive())
+	})
+	It("retries a panicking action", func() {
+		var attempts int
+		err := cron.WrapWithOptions(
+			run.Func(func(ctx context.Context) error {
+				attempts++
+				if attempts < 2 {
+					panic("banana")
+				}
+				return nil
+			}),
+			cron.Options{
+				Name:          "recover-retry-job",
+				RecoverPanics: true,
+				Retry: cron.RetryOptions{
+					MaxAttempts:    2,
+					InitialBackoff: libtime.Millisecond,
+				},
+			},
+		).Run(ctx)
+		Expect(err).To(BeNil())
+		Expect(attempts).To(Equal(2))
+	})
+})
+
+// counterValue reads the counter with the given metric name for the given job name
+// straight off the default registry.
+func counterValue(metricName string, name string) float64 {
+	families, err := prometheus.DefaultGatherer.Gather()
+	Expect(err).To(BeNil())
+	for _, family := range families {
+		if family.GetName() != metricName {
+			continue
+		}
+		for _, metric := range family.GetMetric() {
+			for _, label := range metric.GetLabel() {
+				if label.GetName() == "name" && label.GetValue() == name {
+					return metric.GetCounter().GetValue()
+				}
+			}
+		}
+	}
+	return 0
+}
@@ -0,0 +1,144 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package cron
+
+import (
+	"context"
+	"math"
+	"math/rand/v2"
+	"time"
+
+	"github.com/bborbe/errors"
+	"github.com/bborbe/run"
+	libtime "github.com/bborbe/time"
+)
+
+// RetryOptions configures how WrapWithRetry retries failed executions.
+type RetryOptions struct {
+	// MaxAttempts is the total number of attempts including the first one.
+	// A value below 2 disables retries.
+	MaxAttempts int
+	// InitialBackoff is the delay before the first retry.
+	InitialBackoff libtime.Duration
+	// MaxBackoff caps the delay between two attempts. A value of 0 disables the cap.
+	MaxBackoff libtime.Duration
+	// Multiplier grows the delay after each retry. Values below 1 use 2.
+	Multiplier float64
+	// Jitter randomizes each delay by up to this fraction, e.g. 0.2 for +/- 20%.
+	Jitter float64
+	// MaxElapsedTime stops retrying once the next attempt would start later than this
+	// after the first one. A value of 0 disables the limit.
+	MaxElapsedTime libtime.Duration
+	// IsRetryable decides whether a failed attempt is retried. Nil retries every error.
+	IsRetryable func(err error) bool
+	// Metrics records attempts and failed attempts. Nil disables attempt metrics.
+	Metrics Metrics
+	// Clock provides the timers for the backoff. Nil uses NewClock.
+	Clock Clock
+}
+
+// DefaultRetryOptions returns RetryOptions with three attempts and exponential backoff
+// starting at one second.
+func DefaultRetryOptions() RetryOptions {
+	return RetryOptions{
+		MaxAttempts:    3,
+		InitialBackoff: libtime.Second,
+		MaxBackoff:     libtime.Minute,
+		Multiplier:     2,
+		Jitter:         0.2,
+		MaxElapsedTime: 0, // unlimited
+	}
+}
+
+// WrapWithRetry wraps a runnable with retries and exponential backoff.
+// If MaxAttempts is below 2, the original runnable is returned unchanged.
+// Retrying stops early if the context is done or IsRetryable rejects the error.
+func WrapWithRetry(name string, retryOptions RetryOptions, fn run.Runnable) run.Runnable {
+	if retryOptions.MaxAttempts < 2 {
+		jobLogger(context.Background(), name).Debug("retry is disabled")
+		return fn
+	}
+	clock := retryOptions.Clock
+	if clock == nil {
+		clock = NewClock()
+	}
+	var metrics RetryMetrics
+	if retryOptions.Metrics != nil {
+		metrics = extendMetrics(retryOptions.Metrics)
+	}
+	return run.Func(func(ctx context.Context) error {
+		start := clock.Now()
+		for attempt := 1; ; attempt++ {
+			if metrics != nil {
+				metrics.IncreaseAttempt(name)
+			}
+			setAttempt(ctx, attempt)
+			err := fn.Run(ctx)
+			if err == nil {
+				return nil
+			}
+			if metrics != nil {
+				metrics.IncreaseAttemptFailed(name)
+			}
+			backoff := retryOptions.backoff(attempt)
+			if !retryOptions.shouldRetry(ctx, err, attempt, clock.Now().Sub(start)+backoff) {
+				return errors.Wrapf(ctx, err, "cron '%s' failed after %d attempts", name, attempt)
+			}
+			jobLogger(ctx, name).InfoContext(
+				ctx,
+				"attempt failed, retry",
+				"attempt", attempt,
+				"backoff", backoff,
+				LogKeyError, err,
+			)
+			timer := clock.NewTimer(backoff)
+			select {
+			case <-ctx.Done():
+				timer.Stop()
+				return errors.Wrapf(ctx, err, "cron '%s' failed after %d attempts", name, attempt)
+			case <-timer.C():
+			}
+		}
+	})
+}
+
+func (r RetryOptions) shouldRetry(
+	ctx context.Context,
+	err error,
+	attempt int,
+	elapsedAtNextAttempt time.Duration,
+) bool {
+	if attempt >= r.MaxAttempts || ctx.Err() != nil {
+		return false
+	}
+	if r.MaxElapsedTime > 0 && elapsedAtNextAttempt > r.MaxElapsedTime.Duration() {
+		return false
+	}
+	return r.IsRetryable == nil || r.IsRetryable(err)
+}
+
+// backoff returns the delay after the given failed attempt.
+func (r RetryOptions) backoff(attempt int) time.Duration {
+	multiplier := r.Multiplier
+	if multiplier < 1 {
+		multiplier = 2
+	}
+	delay := float64(r.InitialBackoff.Duration()) * math.Pow(multiplier, float64(attempt-1))
+	if math.IsNaN(delay) {
+		// a zero initial backoff times an infinite factor
+		delay = 0
+	}
+	if r.MaxBackoff > 0 {
+		delay = math.Min(delay, float64(r.MaxBackoff.Duration()))
+	}
+	if r.Jitter > 0 {
+		delay += delay * r.Jitter * (2*rand.Float64() - 1) //nolint:gosec // jitter needs no crypto randomness
+	}
+	// without MaxBackoff the delay grows beyond the largest time.Duration after enough attempts
+	if delay >= math.MaxInt64 {
+		return time.Duration(math.MaxInt64)
+	}
+	return time.Duration(math.Max(delay, 0))
+}
@@ -0,0 +1,208 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package cron_test
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"time"
+
+	"github.com/bborbe/run"
+	libtime "github.com/bborbe/time"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/bborbe/cron"
+	"github.com/bborbe/cron/mocks"
+)
+
+var _ = Describe("WrapWithRetry", func() {
+	var ctx context.Context
+	var cancel context.CancelFunc
+	var clock *cron.FakeClock
+	var metrics *mocks.CronMetrics
+	var retryOptions cron.RetryOptions
+	var calls int64
+	var failures int64
+	var actionErr error
+	var result chan error
+
+	BeforeEach(func() {
+		ctx, cancel = context.WithCancel(context.Background())
+		clock = cron.NewFakeClock(time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC))
+		metrics = &mocks.CronMetrics{}
+		retryOptions = cron.RetryOptions{
+			MaxAttempts:    3,
+			InitialBackoff: libtime.Second,
+			Multiplier:     2,
+			Metrics:        metrics,
+			Clock:          clock,
+		}
+		atomic.StoreInt64(&calls, 0)
+		failures = 0
+		actionErr = errors.New("banana")
+		result = make(chan error, 1)
+	})
+	AfterEach(func() {
+		cancel()
+	})
+	JustBeforeEach(func() {
+		failures, actionErr := failures, actionErr
+		fn := cron.WrapWithRetry("retry-job", retryOptions, run.Func(func(ctx context.Context) error {
+			if atomic.AddInt64(&calls, 1) <= failures {
+				return actionErr
+			}
+			return nil
+		}))
+		go func(ctx context.Context, result chan<- error) {
+			result <- fn.Run(ctx)
+		}(ctx, result)
+	})
+
+	Context("success", func() {
+		It("runs once", func() {
+			Eventually(result).Should(Receive(BeNil()))
+			Expect(atomic.LoadInt64(&calls)).To(Equal(int64(1)))
+		})
+		It("records the attempt", func() {
+			Eventually(result).Should(Receive(BeNil()))
+			Expect(metrics.IncreaseAttemptCallCount()).To(Equal(1))
+			Expect(metrics.IncreaseAttemptFailedCallCount()).To(Equal(0))
+		})
+	})
+	Context("transient failure", func() {
+		BeforeEach(func() {
+			failures = 2
+		})
+		It("retries with exponential backoff", func() {
+			Expect(clock.WaitForTimers(ctx, 1)).To(Succeed())
+			clock.Add(999 * time.Millisecond)
+			Expect(atomic.LoadInt64(&calls)).To(Equal(int64(1)))
+			clock.Add(time.Millisecond)
+
+			Expect(clock.WaitForTimers(ctx, 1)).To(Succeed())
+			Expect(atomic.LoadInt64(&calls)).To(Equal(int64(2)))
+			clock.Add(1999 * time.Millisecond)
+			Expect(atomic.LoadInt64(&calls)).To(Equal(int64(2)))
+			clock.Add(time.Millisecond)
+
+			Eventually(result).Should(Receive(BeNil()))
+			Expect(atomic.LoadInt64(&calls)).To(Equal(int64(3)))
+		})
+		It("records every attempt", func() {
+			Expect(clock.WaitForTimers(ctx, 1)).To(Succeed())
+			clock.Add(time.Second)
+			Expect(clock.WaitForTimers(ctx, 1)).To(Succeed())
+			clock.Add(2 * time.Second)
+			Eventually(result).Should(Receive(BeNil()))
+			Expect(metrics.IncreaseAttemptCallCount()).To(Equal(3))
+			Expect(metrics.IncreaseAttemptFailedCallCount()).To(Equal(2))
+			Expect(metrics.IncreaseAttemptArgsForCall(0)).To(Equal("retry-job"))
+		})
+	})
+	Context("permanent failure", func() {
+		BeforeEach(func() {
+			failures = 100
+		})
+		It("returns the error after max attempts", func() {
+			Expect(clock.WaitForTimers(ctx, 1)).To(Succeed())
+			clock.Add(time.Second)
+			Expect(clock.WaitForTimers(ctx, 1)).To(Succeed())
+			clock.Add(2 * time.Second)
+			var err error
+			Eventually(result).Should(Receive(&err))
+			Expect(errors.Is(err, actionErr)).To(BeTrue())
+			Expect(err.Error()).To(ContainSubstring("after 3 attempts"))
+			Expect(atomic.LoadInt64(&calls)).To(Equal(int64(3)))
+		})
+		Context("with max backoff", func() {
+			BeforeEach(func() {
+				retryOptions.Multiplier = 10
+				retryOptions.MaxBackoff = 2 * libtime.Second
+			})
+			It("caps the backoff", func() {
+				Expect(clock.WaitForTimers(ctx, 1)).To(Succeed())
+				clock.Add(time.Second)
+				Expect(clock.WaitForTimers(ctx, 1)).To(Succeed())
+				clock.Add(2 * time.Second)
+				Eventually(result).Should(Receive(HaveOccurred()))
+				Expect(atomic.LoadInt64(&calls)).To(Equal(int64(3)))
+			})
+		})
+		Context("without max backoff", func() {
+			BeforeEach(func() {
+				retryOptions.Multiplier = 1e12
+				retryOptions.MaxBackoff = 0
+			})
+			It("does not overflow the backoff", func() {
+				Expect(clock.WaitForTimers(ctx, 1)).To(Succeed())
+				clock.Add(time.Second)
+				Expect(clock.WaitForTimers(ctx, 1)).To(Succeed())
+				clock.Add(time.Hour)
+				Consistently(func() int64 {
+					return atomic.LoadInt64(&calls)
+				}, 100*time.Millisecond).Should(Equal(int64(2)))
+			})
+		})
+		Context("with max elapsed time", func() {
+			BeforeEach(func() {
+				retryOptions.MaxElapsedTime = 2 * libtime.Second
+			})
+			It("stops once the next attempt would start too late", func() {
+				Expect(clock.WaitForTimers(ctx, 1)).To(Succeed())
+				clock.Add(time.Second)
+				Eventually(result).Should(Receive(HaveOccurred()))
+				Expect(atomic.LoadInt64(&calls)).To(Equal(int64(2)))
+			})
+		})
+		Context("not retryable", func() {
+			BeforeEach(func() {
+				retryOptions.IsRetryable = func(err error) bool {
+					return false
+				}
+			})
+			It("does not retry", func() {
+				Eventually(result).Should(Receive(HaveOccurred()))
+				Expect(atomic.LoadInt64(&calls)).To(Equal(int64(1)))
+			})
+		})
+		It("stops on cancel during backoff", func() {
+			Expect(clock.WaitForTimers(ctx, 1)).To(Succeed())
+			cancel()
+			var err error
+			Eventually(result).Should(Receive(&err))
+			Expect(errors.Is(err, actionErr)).To(BeTrue())
+			Expect(atomic.LoadInt64(&calls)).To(Equal(int64(1)))
+			Expect(clock.Timers()).To(Equal(0))
+		})
+	})
+	Context("disabled", func() {
+		BeforeEach(func() {
+			retryOptions.MaxAttempts = 1
+			failures = 100
+		})
+		It("returns the original error", func() {
+			Eventually(result).Should(Receive(Equal(actionErr)))
+			Expect(atomic.LoadInt64(&calls)).To(Equal(int64(1)))
+		})
+		It("does not record attempts", func() {
+			Eventually(result).Should(Receive())
+			Expect(metrics.IncreaseAttemptCallCount()).To(Equal(0))
+		})
+	})
+})
+
+var _ = Describe("DefaultRetryOptions", func() {
+	It("returns default values", func() {
+		retryOptions := cron.DefaultRetryOptions()
+		Expect(retryOptions.MaxAttempts).To(Equal(3))
+		Expect(retryOptions.InitialBackoff).To(Equal(libtime.Second))
+		Expect(retryOptions.MaxBackoff).To(Equal(libtime.Minute))
+		Expect(retryOptions.Multiplier).To(Equal(2.0))
+		Expect(retryOptions.Jitter).To(Equal(0.2))
+		Expect(retryOptions.MaxElapsedTime).To(Equal(libtime.Duration(0)))
+	})
+})
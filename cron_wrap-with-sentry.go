@@ -0,0 +1,278 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package cron
+
+import (
+	"context"
+	"runtime/debug"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/bborbe/errors"
+	"github.com/bborbe/run"
+	libsentry "github.com/bborbe/sentry"
+	"github.com/getsentry/sentry-go"
+)
+
+// Tags attached to the Sentry events of failed executions.
+const (
+	SentryTagName          = "cron.job.name"
+	SentryTagSchedule      = "cron.job.schedule"
+	SentryTagScheduledTime = "cron.job.scheduled_time"
+	SentryTagRunID         = "cron.job.run_id"
+	SentryTagAttempt       = "cron.job.attempt"
+	SentryTagPanic         = "cron.job.panic"
+)
+
+//counterfeiter:generate -o mocks/cron-sentry-check-in-client.go --fake-name CronSentryCheckInClient . SentryCheckInClient
+
+// SentryCheckInClient is optionally implemented by the client of WrapWithSentry
+// to send Sentry Crons check-ins. *sentry.Client implements it.
+type SentryCheckInClient interface {
+	CaptureCheckIn(
+		checkIn *sentry.CheckIn,
+		monitorConfig *sentry.MonitorConfig,
+		scope sentry.EventModifier,
+	) *sentry.EventID
+}
+
+// SentryClientWithCheckIns combines a libsentry.Client with a SentryCheckInClient,
+// e.g. the *sentry.Client of the hub, so WrapWithSentry also sends check-ins.
+type SentryClientWithCheckIns struct {
+	libsentry.Client
+	SentryCheckInClient
+}
+
+// WrapWithSentry wraps a runnable and captures failed executions and panics in Sentry,
+// tagged with job name, schedule, scheduled time, run ID and attempt.
+// Panics are captured and re-panicked, combine it with WrapWithRecover to return them as errors.
+//
+// If the client implements SentryCheckInClient, e.g. SentryClientWithCheckIns, it also sends
+// Sentry Crons check-ins with the slugified job name as monitor slug: in_progress on start,
+// ok or error with the duration on completion. The monitor config carries the schedule of the cron,
+// so Sentry alerts on missed executions. Expressions are sent without the seconds field,
+// intervals in whole minutes, hours or days.
+func WrapWithSentry(client libsentry.Client, name string, fn run.Runnable) run.Runnable {
+	return wrapWithSentry(client, name, nil, NewClock(), fn)
+}
+
+// wrapWithSentry works like WrapWithSentry, reports expressions in the given location
+// if they have no time zone prefix and reads start and duration from the given clock.
+func wrapWithSentry(
+	client libsentry.Client,
+	name string,
+	location *time.Location,
+	clock Clock,
+	fn run.Runnable,
+) run.Runnable {
+	fn = wrapWithSentryException(client, name, clock, fn)
+	if checkInClient, ok := client.(SentryCheckInClient); ok {
+		fn = wrapWithSentryCheckIn(checkInClient, name, location, clock, fn)
+	}
+	return fn
+}
+
+// wrapWithSentryException captures failed executions and panics.
+func wrapWithSentryException(client libsentry.Client, name string, clock Clock, fn run.Runnable) run.Runnable {
+	return run.Func(func(ctx context.Context) (err error) {
+		defer func() {
+			if r := recover(); r != nil {
+				captureSentryException(ctx, client, name, clock, &PanicError{
+					Name:  name,
+					Value: r,
+					Stack: debug.Stack(),
+				}, true)
+				panic(r)
+			}
+		}()
+		if err = fn.Run(ctx); err != nil {
+			var panicErr *PanicError
+			captureSentryException(ctx, client, name, clock, err, errors.As(err, &panicErr))
+			return err
+		}
+		return nil
+	})
+}
+
+func captureSentryException(
+	ctx context.Context,
+	client libsentry.Client,
+	name string,
+	clock Clock,
+	err error,
+	panicked bool,
+) {
+	executionInfo := currentExecution(ctx, name, clock)
+	tags := map[string]string{
+		SentryTagName:    name,
+		SentryTagAttempt: strconv.Itoa(executionInfo.Attempt),
+		SentryTagPanic:   strconv.FormatBool(panicked),
+	}
+	if executionInfo.Schedule != "" {
+		tags[SentryTagSchedule] = executionInfo.Schedule
+	}
+	if !executionInfo.ScheduledTime.IsZero() {
+		tags[SentryTagScheduledTime] = executionInfo.ScheduledTime.Format(time.RFC3339)
+	}
+	if executionInfo.RunID != "" {
+		tags[SentryTagRunID] = executionInfo.RunID
+	}
+	scope := sentry.NewScope()
+	scope.SetTags(tags)
+	if panicked {
+		scope.SetLevel(sentry.LevelFatal)
+	}
+	client.CaptureException(
+		err,
+		&sentry.EventHint{
+			Context:           ctx,
+			OriginalException: err,
+		},
+		scope,
+	)
+}
+
+// wrapWithSentryCheckIn sends a check-in on start and on completion of each execution.
+func wrapWithSentryCheckIn(
+	client SentryCheckInClient,
+	name string,
+	location *time.Location,
+	clock Clock,
+	fn run.Runnable,
+) run.Runnable {
+	monitorSlug := sentryMonitorSlug(name)
+	return run.Func(func(ctx context.Context) (err error) {
+		monitorConfig := sentryMonitorConfig(currentExecution(ctx, name, clock).Schedule, location)
+		checkInID := client.CaptureCheckIn(
+			&sentry.CheckIn{
+				MonitorSlug: monitorSlug,
+				Status:      sentry.CheckInStatusInProgress,
+			},
+			monitorConfig,
+			nil,
+		)
+		start := clock.Now()
+		completed := false
+		defer func() {
+			if checkInID == nil {
+				return
+			}
+			status := sentry.CheckInStatusOK
+			if err != nil || !completed {
+				status = sentry.CheckInStatusError
+			}
+			client.CaptureCheckIn(
+				&sentry.CheckIn{
+					ID:          *checkInID,
+					MonitorSlug: monitorSlug,
+					Status:      status,
+					Duration:    clock.Now().Sub(start),
+				},
+				monitorConfig,
+				nil,
+			)
+		}()
+		err = fn.Run(ctx)
+		completed = true
+		return err
+	})
+}
+
+// sentryMonitorConfig returns the monitor config of an expression or interval schedule,
+// or nil if Sentry cannot represent it.
+func sentryMonitorConfig(schedule string, location *time.Location) *sentry.MonitorConfig {
+	if schedule == "" {
+		return nil
+	}
+	if interval, err := time.ParseDuration(schedule); err == nil {
+		return sentryIntervalConfig(interval)
+	}
+	monitorConfig := &sentry.MonitorConfig{}
+	if location != nil {
+		monitorConfig.Timezone = location.String()
+	}
+	fields := strings.Fields(schedule)
+	if len(fields) > 0 && (strings.HasPrefix(fields[0], "CRON_TZ=") || strings.HasPrefix(fields[0], "TZ=")) {
+		_, monitorConfig.Timezone, _ = strings.Cut(fields[0], "=")
+		fields = fields[1:]
+	}
+	for i, field := range fields {
+		// Sentry does not know the Quartz "no specific value" of day of month and day of week
+		if field == "?" {
+			fields[i] = "*"
+		}
+	}
+	switch {
+	case len(fields) == 6:
+		// Sentry has no seconds field, keep expressions firing at a fixed second
+		if _, err := strconv.Atoi(fields[0]); err != nil {
+			return nil
+		}
+		fields = fields[1:]
+	case len(fields) == 2 && fields[0] == "@every":
+		interval, err := time.ParseDuration(fields[1])
+		if err != nil {
+			return nil
+		}
+		return sentryIntervalConfig(interval)
+	case len(fields) == 1 && sentryDescriptors[fields[0]] != "":
+		fields = strings.Fields(sentryDescriptors[fields[0]])
+	}
+	if len(fields) != 5 {
+		return nil
+	}
+	monitorConfig.Schedule = sentry.CrontabSchedule(strings.Join(fields, " "))
+	return monitorConfig
+}
+
+// sentryMonitorSlug returns the name as Sentry monitor slug of lowercase letters,
+// digits, hyphens and underscores, e.g. "Nightly Report" as "nightly-report".
+func sentryMonitorSlug(name string) string {
+	var sb strings.Builder
+	hyphen := false
+	for _, r := range strings.ToLower(name) {
+		if r >= 'a' && r <= 'z' || r >= '0' && r <= '9' || r == '_' {
+			sb.WriteRune(r)
+			hyphen = false
+			continue
+		}
+		if !hyphen && sb.Len() > 0 {
+			sb.WriteRune('-')
+			hyphen = true
+		}
+	}
+	return strings.TrimSuffix(sb.String(), "-")
+}
+
+// sentryDescriptors maps the descriptors of the parser to crontab expressions.
+var sentryDescriptors = map[string]string{
+	"@yearly":   "0 0 1 1 *",
+	"@annually": "0 0 1 1 *",
+	"@monthly":  "0 0 1 * *",
+	"@weekly":   "0 0 * * 0",
+	"@daily":    "0 0 * * *",
+	"@midnight": "0 0 * * *",
+	"@hourly":   "0 * * * *",
+}
+
+func sentryIntervalConfig(interval time.Duration) *sentry.MonitorConfig {
+	units := []struct {
+		duration time.Duration
+		unit     sentry.MonitorScheduleUnit
+	}{
+		{duration: 24 * time.Hour, unit: sentry.MonitorScheduleUnitDay},
+		{duration: time.Hour, unit: sentry.MonitorScheduleUnitHour},
+		{duration: time.Minute, unit: sentry.MonitorScheduleUnitMinute},
+	}
+	for _, u := range units {
+		if interval >= u.duration && interval%u.duration == 0 {
+			return &sentry.MonitorConfig{
+				Schedule: sentry.IntervalSchedule(int64(interval/u.duration), u.unit),
+			}
+		}
+	}
+	return nil
+}
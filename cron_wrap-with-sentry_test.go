@@ -0,0 +1,250 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package cron_test
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/bborbe/run"
+	sentrymocks "github.com/bborbe/sentry/mocks"
+	libtime "github.com/bborbe/time"
+	"github.com/getsentry/sentry-go"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/bborbe/cron"
+	"github.com/bborbe/cron/mocks"
+)
+
+var _ = Describe("WrapWithSentry", func() {
+	var ctx context.Context
+	var sentryClient *sentrymocks.SentryClient
+
+	// capturedTags returns the tags the scope of the given capture sets.
+	capturedTags := func(i int) map[string]string {
+		_, _, scope := sentryClient.CaptureExceptionArgsForCall(i)
+		return scope.ApplyToEvent(&sentry.Event{}, nil, nil).Tags
+	}
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		sentryClient = &sentrymocks.SentryClient{}
+	})
+
+	It("does not capture successful executions", func() {
+		fn := cron.WrapWithSentry(sentryClient, "sentry-job", run.Func(func(ctx context.Context) error {
+			return nil
+		}))
+		Expect(fn.Run(ctx)).To(Succeed())
+		Expect(sentryClient.CaptureExceptionCallCount()).To(Equal(0))
+	})
+
+	It("captures failed executions with job tags", func() {
+		fn := cron.WrapWithSentry(sentryClient, "sentry-job", run.Func(func(ctx context.Context) error {
+			return errors.New("banana")
+		}))
+		Expect(fn.Run(ctx)).To(MatchError("banana"))
+
+		Expect(sentryClient.CaptureExceptionCallCount()).To(Equal(1))
+		err, hint, _ := sentryClient.CaptureExceptionArgsForCall(0)
+		Expect(err).To(MatchError("banana"))
+		Expect(hint.Context).NotTo(BeNil())
+		tags := capturedTags(0)
+		Expect(tags).To(HaveKeyWithValue(cron.SentryTagName, "sentry-job"))
+		Expect(tags).To(HaveKeyWithValue(cron.SentryTagAttempt, "1"))
+		Expect(tags).To(HaveKeyWithValue(cron.SentryTagPanic, "false"))
+	})
+
+	It("captures and re-panics panics", func() {
+		fn := cron.WrapWithSentry(sentryClient, "sentry-job", run.Func(func(ctx context.Context) error {
+			panic("banana")
+		}))
+		Expect(func() {
+			_ = fn.Run(ctx)
+		}).To(PanicWith("banana"))
+
+		Expect(sentryClient.CaptureExceptionCallCount()).To(Equal(1))
+		err, _, _ := sentryClient.CaptureExceptionArgsForCall(0)
+		var panicErr *cron.PanicError
+		Expect(errors.As(err, &panicErr)).To(BeTrue())
+		Expect(capturedTags(0)).To(HaveKeyWithValue(cron.SentryTagPanic, "true"))
+	})
+
+	It("captures recovered panics via options with execution tags", func() {
+		b := cron.NewOneTimeCronWithOptions(
+			run.Func(func(ctx context.Context) error {
+				panic("banana")
+			}),
+			cron.Options{
+				Name:          "sentry-job",
+				RecoverPanics: true,
+				SentryClient:  sentryClient,
+			},
+		)
+		Expect(b.Run(ctx)).NotTo(Succeed())
+
+		Expect(sentryClient.CaptureExceptionCallCount()).To(Equal(1))
+		tags := capturedTags(0)
+		Expect(tags).To(HaveKeyWithValue(cron.SentryTagName, "sentry-job"))
+		Expect(tags).To(HaveKeyWithValue(cron.SentryTagPanic, "true"))
+		Expect(tags).To(HaveKeyWithValue(cron.SentryTagRunID, Not(BeEmpty())))
+	})
+})
+
+var _ = Describe("WrapWithSentry with check-ins", func() {
+	var ctx context.Context
+	var cancel context.CancelFunc
+	var sentryClient *sentrymocks.SentryClient
+	var checkInClient *mocks.CronSentryCheckInClient
+	var client cron.SentryClientWithCheckIns
+	var checkInID sentry.EventID
+
+	BeforeEach(func() {
+		ctx, cancel = context.WithCancel(context.Background())
+		sentryClient = &sentrymocks.SentryClient{}
+		checkInClient = &mocks.CronSentryCheckInClient{}
+		client = cron.SentryClientWithCheckIns{
+			Client:              sentryClient,
+			SentryCheckInClient: checkInClient,
+		}
+		checkInID = sentry.EventID("check-in-id")
+		checkInClient.CaptureCheckInReturns(&checkInID)
+	})
+	AfterEach(func() {
+		cancel()
+	})
+
+	It("sends in_progress and ok", func() {
+		fn := cron.WrapWithSentry(client, "sentry-job", run.Func(func(ctx context.Context) error {
+			return nil
+		}))
+		Expect(fn.Run(ctx)).To(Succeed())
+
+		Expect(checkInClient.CaptureCheckInCallCount()).To(Equal(2))
+		checkIn, monitorConfig, _ := checkInClient.CaptureCheckInArgsForCall(0)
+		Expect(checkIn.MonitorSlug).To(Equal("sentry-job"))
+		Expect(checkIn.Status).To(Equal(sentry.CheckInStatusInProgress))
+		Expect(monitorConfig).To(BeNil())
+		checkIn, _, _ = checkInClient.CaptureCheckInArgsForCall(1)
+		Expect(checkIn.ID).To(Equal(checkInID))
+		Expect(checkIn.Status).To(Equal(sentry.CheckInStatusOK))
+	})
+
+	It("sends error for failed executions and captures them", func() {
+		fn := cron.WrapWithSentry(client, "sentry-job", run.Func(func(ctx context.Context) error {
+			return errors.New("banana")
+		}))
+		Expect(fn.Run(ctx)).NotTo(Succeed())
+
+		Expect(checkInClient.CaptureCheckInCallCount()).To(Equal(2))
+		checkIn, _, _ := checkInClient.CaptureCheckInArgsForCall(1)
+		Expect(checkIn.Status).To(Equal(sentry.CheckInStatusError))
+		Expect(sentryClient.CaptureExceptionCallCount()).To(Equal(1))
+	})
+
+	It("sends the slugified name as monitor slug", func() {
+		fn := cron.WrapWithSentry(client, "Nightly Report/EU", run.Func(func(ctx context.Context) error {
+			return nil
+		}))
+		Expect(fn.Run(ctx)).To(Succeed())
+
+		checkIn, _, _ := checkInClient.CaptureCheckInArgsForCall(0)
+		Expect(checkIn.MonitorSlug).To(Equal("nightly-report-eu"))
+	})
+
+	It("sends error for panics", func() {
+		fn := cron.WrapWithSentry(client, "sentry-job", run.Func(func(ctx context.Context) error {
+			panic("banana")
+		}))
+		Expect(func() {
+			_ = fn.Run(ctx)
+		}).To(Panic())
+
+		Expect(checkInClient.CaptureCheckInCallCount()).To(Equal(2))
+		checkIn, _, _ := checkInClient.CaptureCheckInArgsForCall(1)
+		Expect(checkIn.Status).To(Equal(sentry.CheckInStatusError))
+	})
+
+	It("sends the expression without seconds as crontab schedule", func() {
+		clock := cron.NewFakeClock(time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC))
+		b := cron.NewExpressionCronWithOptions("CRON_TZ=Europe/Berlin 0 30 * * * *", run.Func(func(ctx context.Context) error {
+			return nil
+		}), cron.Options{
+			Name:         "sentry-job",
+			Clock:        clock,
+			SentryClient: client,
+		})
+		go func() {
+			_ = b.Run(ctx)
+		}()
+		Expect(clock.WaitForTimers(ctx, 1)).To(Succeed())
+		clock.Add(30 * time.Minute)
+		Eventually(checkInClient.CaptureCheckInCallCount).Should(Equal(2))
+
+		_, monitorConfig, _ := checkInClient.CaptureCheckInArgsForCall(0)
+		Expect(monitorConfig).NotTo(BeNil())
+		Expect(monitorConfig.Schedule).To(Equal(sentry.CrontabSchedule("30 * * * *")))
+		Expect(monitorConfig.Timezone).To(Equal("Europe/Berlin"))
+	})
+
+	It("sends the Quartz question mark as asterisk", func() {
+		clock := cron.NewFakeClock(time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC))
+		b := cron.NewExpressionCronWithOptions("0 0 * ? * *", run.Func(func(ctx context.Context) error {
+			return nil
+		}), cron.Options{
+			Name:         "sentry-job",
+			Clock:        clock,
+			SentryClient: client,
+		})
+		go func() {
+			_ = b.Run(ctx)
+		}()
+		Expect(clock.WaitForTimers(ctx, 1)).To(Succeed())
+		clock.Add(time.Hour)
+		Eventually(checkInClient.CaptureCheckInCallCount).Should(Equal(2))
+
+		_, monitorConfig, _ := checkInClient.CaptureCheckInArgsForCall(0)
+		Expect(monitorConfig).NotTo(BeNil())
+		Expect(monitorConfig.Schedule).To(Equal(sentry.CrontabSchedule("0 * * * *")))
+	})
+
+	It("sends the interval as interval schedule", func() {
+		clock := cron.NewFakeClock(time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC))
+		b := cron.NewIntervalCronWithOptions(2*libtime.Hour, run.Func(func(ctx context.Context) error {
+			return nil
+		}), cron.Options{
+			Name:         "sentry-job",
+			Clock:        clock,
+			SentryClient: client,
+		})
+		go func() {
+			_ = b.Run(ctx)
+		}()
+		Eventually(checkInClient.CaptureCheckInCallCount).Should(Equal(2))
+
+		_, monitorConfig, _ := checkInClient.CaptureCheckInArgsForCall(0)
+		Expect(monitorConfig).NotTo(BeNil())
+		Expect(monitorConfig.Schedule).To(Equal(sentry.IntervalSchedule(2, sentry.MonitorScheduleUnitHour)))
+	})
+
+	It("measures the duration with the clock of the options", func() {
+		clock := cron.NewFakeClock(time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC))
+		fn := cron.WrapWithOptions(run.Func(func(ctx context.Context) error {
+			clock.Add(time.Minute)
+			return nil
+		}), cron.Options{
+			Name:         "sentry-job",
+			Clock:        clock,
+			SentryClient: client,
+		})
+		Expect(fn.Run(ctx)).To(Succeed())
+
+		Expect(checkInClient.CaptureCheckInCallCount()).To(Equal(2))
+		checkIn, _, _ := checkInClient.CaptureCheckInArgsForCall(1)
+		Expect(checkIn.Duration).To(Equal(time.Minute))
+	})
+})
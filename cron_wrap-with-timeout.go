@@ -9,21 +9,52 @@ import (
 
 	"github.com/bborbe/run"
 	libtime "github.com/bborbe/time"
-	"github.com/golang/glog"
 )
 
 // WrapWithTimeout wraps a runnable with timeout enforcement.
 // If timeout is <= 0, the original runnable is returned unchanged.
-// Otherwise, executions are cancelled if they exceed the specified duration.
+// Otherwise, executions are cancelled if they exceed the specified duration,
+// counted as cron_job_timeouts_total.
 func WrapWithTimeout(name string, timeout libtime.Duration, fn run.Runnable) run.Runnable {
+	return WrapWithTimeoutAndMetrics(name, timeout, NewMetrics(), fn)
+}
+
+// WrapWithTimeoutAndMetrics works like WrapWithTimeout but counts timeouts in the given metrics,
+// e.g. created by NewMetricsWithRegisterer. Metrics not implementing TimeoutMetrics ignore them.
+func WrapWithTimeoutAndMetrics(
+	name string,
+	timeout libtime.Duration,
+	metrics Metrics,
+	fn run.Runnable,
+) run.Runnable {
+	return wrapWithTimeout(name, timeout, NewClock(), extendMetrics(metrics), NopListener{}, fn)
+}
+
+// wrapWithTimeout works like WrapWithTimeout, counts timeouts in the given metrics
+// and reports them to the listener.
+func wrapWithTimeout(
+	name string,
+	timeout libtime.Duration,
+	clock Clock,
+	metrics ExtendedMetrics,
+	listener Listener,
+	fn run.Runnable,
+) run.Runnable {
 	if timeout <= 0 {
-		glog.V(3).Infof("timeout is disabled for cron '%s'", name)
+		jobLogger(context.Background(), name).Debug("timeout is disabled")
 		return fn
 	}
 	return run.Func(func(ctx context.Context) error {
-		ctx, cancel := context.WithTimeout(ctx, timeout.Duration())
+		timeoutCtx, cancel := context.WithTimeout(ctx, timeout.Duration())
 		defer cancel()
-		glog.V(3).Infof("add timeout %v to cron '%s'", timeout, name)
-		return fn.Run(ctx)
+		logger := jobLogger(ctx, name)
+		logger.DebugContext(ctx, "add timeout", "timeout", timeout.Duration())
+		err := fn.Run(timeoutCtx)
+		if timeoutCtx.Err() == context.DeadlineExceeded && ctx.Err() == nil {
+			logger.InfoContext(ctx, "cron exceeded timeout", "timeout", timeout.Duration())
+			metrics.IncreaseTimeout(name)
+			listener.OnTimeout(ctx, currentExecution(ctx, name, clock), timeout.Duration())
+		}
+		return err
 	})
 }
@@ -14,6 +14,7 @@ import (
 	. "github.com/onsi/gomega"
 
 	"github.com/bborbe/cron"
+	"github.com/bborbe/cron/mocks"
 )
 
 var _ = Describe("WrapWithTimeout", func() {
@@ -53,6 +54,9 @@ var _ = Describe("WrapWithTimeout", func() {
 		It("does not execute function fully", func() {
 			Expect(counter).To(Equal(0))
 		})
+		It("counts the timeout", func() {
+			Expect(counterValue("cron_job_timeouts_total", "test-cron")).To(BeNumerically(">=", 1))
+		})
 	})
 	Context("timeout not exceeded", func() {
 		BeforeEach(func() {
@@ -91,3 +95,38 @@ var _ = Describe("WrapWithTimeout", func() {
 		})
 	})
 })
+
+var _ = Describe("WrapWithTimeoutAndMetrics", func() {
+	var ctx context.Context
+	var metrics *mocks.CronMetrics
+	BeforeEach(func() {
+		ctx = context.Background()
+		metrics = &mocks.CronMetrics{}
+	})
+	It("counts the timeout in the given metrics", func() {
+		err := cron.WrapWithTimeoutAndMetrics(
+			"timeout-metrics-job",
+			10*libtime.Millisecond,
+			metrics,
+			run.Func(func(ctx context.Context) error {
+				<-ctx.Done()
+				return ctx.Err()
+			}),
+		).Run(ctx)
+		Expect(err).NotTo(BeNil())
+		Expect(metrics.IncreaseTimeoutCallCount()).To(Equal(1))
+		Expect(metrics.IncreaseTimeoutArgsForCall(0)).To(Equal("timeout-metrics-job"))
+	})
+	It("does not count without timeout", func() {
+		err := cron.WrapWithTimeoutAndMetrics(
+			"timeout-metrics-job",
+			libtime.Second,
+			metrics,
+			run.Func(func(ctx context.Context) error {
+				return nil
+			}),
+		).Run(ctx)
+		Expect(err).To(BeNil())
+		Expect(metrics.IncreaseTimeoutCallCount()).To(Equal(0))
+	})
+})
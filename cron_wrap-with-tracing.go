@@ -0,0 +1,89 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package cron
+
+import (
+	"context"
+	"time"
+
+	"github.com/bborbe/errors"
+	"github.com/bborbe/run"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const tracerName = "github.com/bborbe/cron"
+
+// Span attributes set by WrapWithTracing.
+const (
+	TracingAttributeName          = attribute.Key("cron.job.name")
+	TracingAttributeSchedule      = attribute.Key("cron.job.schedule")
+	TracingAttributeScheduledTime = attribute.Key("cron.job.scheduled_time")
+	TracingAttributeRunID         = attribute.Key("cron.job.run_id")
+	TracingAttributeOutcome       = attribute.Key("cron.job.outcome")
+)
+
+// Outcomes reported as TracingAttributeOutcome.
+const (
+	TracingOutcomeSuccess = "success"
+	TracingOutcomeFailure = "failure"
+	TracingOutcomeTimeout = "timeout"
+)
+
+// WrapWithTracing wraps a runnable and starts a new root span per execution.
+// The span carries the job name, the schedule, scheduled time and run ID from ExecutionInfo
+// and the outcome. Failures and timeouts are recorded as error on the span.
+// A span in the incoming context, e.g. of a manual trigger, is added as link.
+func WrapWithTracing(name string, tracerProvider trace.TracerProvider, fn run.Runnable) run.Runnable {
+	tracer := tracerProvider.Tracer(tracerName)
+	return run.Func(func(ctx context.Context) error {
+		ctx, span := tracer.Start(ctx, name, tracingStartOptions(ctx, name)...)
+		defer span.End()
+
+		err := fn.Run(ctx)
+		switch {
+		case err == nil:
+			span.SetAttributes(TracingAttributeOutcome.String(TracingOutcomeSuccess))
+			span.SetStatus(codes.Ok, "")
+		case errors.Is(err, context.DeadlineExceeded):
+			span.SetAttributes(TracingAttributeOutcome.String(TracingOutcomeTimeout))
+			span.RecordError(err)
+			span.SetStatus(codes.Error, "timeout exceeded")
+		default:
+			span.SetAttributes(TracingAttributeOutcome.String(TracingOutcomeFailure))
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+		return err
+	})
+}
+
+func tracingStartOptions(ctx context.Context, name string) []trace.SpanStartOption {
+	attributes := []attribute.KeyValue{
+		TracingAttributeName.String(name),
+	}
+	if executionInfo, ok := ExecutionFromContext(ctx); ok {
+		attributes = append(attributes, TracingAttributeRunID.String(executionInfo.RunID))
+		if executionInfo.Schedule != "" {
+			attributes = append(attributes, TracingAttributeSchedule.String(executionInfo.Schedule))
+		}
+		if !executionInfo.ScheduledTime.IsZero() {
+			attributes = append(
+				attributes,
+				TracingAttributeScheduledTime.String(executionInfo.ScheduledTime.Format(time.RFC3339)),
+			)
+		}
+	}
+	result := []trace.SpanStartOption{
+		trace.WithNewRoot(),
+		trace.WithSpanKind(trace.SpanKindInternal),
+		trace.WithAttributes(attributes...),
+	}
+	if parent := trace.SpanContextFromContext(ctx); parent.IsValid() {
+		result = append(result, trace.WithLinks(trace.Link{SpanContext: parent}))
+	}
+	return result
+}
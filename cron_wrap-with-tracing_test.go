@@ -0,0 +1,121 @@
+// Copyright (c) 2026 Benjamin Borbe All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package cron_test
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/bborbe/run"
+	libtime "github.com/bborbe/time"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+
+	"github.com/bborbe/cron"
+)
+
+var _ = Describe("WrapWithTracing", func() {
+	var ctx context.Context
+	var exporter *tracetest.InMemoryExporter
+	var tracerProvider *sdktrace.TracerProvider
+	var actionError error
+	var action run.Runnable
+
+	// attributes returns the attributes of the given span as map.
+	attributes := func(span tracetest.SpanStub) map[attribute.Key]string {
+		result := map[attribute.Key]string{}
+		for _, keyValue := range span.Attributes {
+			result[keyValue.Key] = keyValue.Value.Emit()
+		}
+		return result
+	}
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		exporter = tracetest.NewInMemoryExporter()
+		tracerProvider = sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter))
+		actionError = nil
+		action = run.Func(func(ctx context.Context) error {
+			return actionError
+		})
+	})
+
+	It("records a successful execution", func() {
+		Expect(cron.WrapWithTracing("tracing-job", tracerProvider, action).Run(ctx)).To(Succeed())
+		spans := exporter.GetSpans()
+		Expect(spans).To(HaveLen(1))
+		Expect(spans[0].Name).To(Equal("tracing-job"))
+		Expect(spans[0].Parent.IsValid()).To(BeFalse())
+		Expect(spans[0].Status.Code).To(Equal(codes.Ok))
+		Expect(attributes(spans[0])).To(HaveKeyWithValue(cron.TracingAttributeName, "tracing-job"))
+		Expect(attributes(spans[0])).To(HaveKeyWithValue(cron.TracingAttributeOutcome, cron.TracingOutcomeSuccess))
+	})
+
+	It("records a failed execution", func() {
+		actionError = errors.New("banana")
+		Expect(cron.WrapWithTracing("tracing-job", tracerProvider, action).Run(ctx)).To(Equal(actionError))
+		spans := exporter.GetSpans()
+		Expect(spans).To(HaveLen(1))
+		Expect(spans[0].Status.Code).To(Equal(codes.Error))
+		Expect(spans[0].Status.Description).To(Equal("banana"))
+		Expect(spans[0].Events).To(HaveLen(1))
+		Expect(attributes(spans[0])).To(HaveKeyWithValue(cron.TracingAttributeOutcome, cron.TracingOutcomeFailure))
+	})
+
+	It("records a timeout", func() {
+		action = run.Func(func(ctx context.Context) error {
+			<-ctx.Done()
+			return ctx.Err()
+		})
+		fn := cron.WrapWithTracing(
+			"tracing-job",
+			tracerProvider,
+			cron.WrapWithTimeout("tracing-job", libtime.Millisecond, action),
+		)
+		Expect(fn.Run(ctx)).NotTo(Succeed())
+		spans := exporter.GetSpans()
+		Expect(spans).To(HaveLen(1))
+		Expect(spans[0].Status.Code).To(Equal(codes.Error))
+		Expect(attributes(spans[0])).To(HaveKeyWithValue(cron.TracingAttributeOutcome, cron.TracingOutcomeTimeout))
+	})
+
+	It("starts a root span linked to the incoming span", func() {
+		parentCtx, parent := tracerProvider.Tracer("test").Start(ctx, "parent")
+		Expect(cron.WrapWithTracing("tracing-job", tracerProvider, action).Run(parentCtx)).To(Succeed())
+		parent.End()
+		spans := exporter.GetSpans()
+		Expect(spans).To(HaveLen(2))
+		Expect(spans[0].Name).To(Equal("tracing-job"))
+		Expect(spans[0].Parent.IsValid()).To(BeFalse())
+		Expect(spans[0].Links).To(HaveLen(1))
+		Expect(spans[0].Links[0].SpanContext.SpanID()).To(Equal(parent.SpanContext().SpanID()))
+	})
+
+	It("is enabled by the options of a cron", func() {
+		clock := cron.NewFakeClock(time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC))
+		runCtx, cancel := context.WithCancel(ctx)
+		defer cancel()
+		b := cron.NewExpressionCronWithOptions("0 0 * * * ?", action, cron.Options{
+			Name:           "tracing-job",
+			TracerProvider: tracerProvider,
+			Clock:          clock,
+		})
+		go func() {
+			_ = b.Run(runCtx)
+		}()
+		Expect(clock.WaitForTimers(runCtx, 1)).To(Succeed())
+		clock.Add(time.Hour)
+		Eventually(exporter.GetSpans).Should(HaveLen(1))
+		span := exporter.GetSpans()[0]
+		Expect(attributes(span)).To(HaveKeyWithValue(cron.TracingAttributeSchedule, "0 0 * * * ?"))
+		Expect(attributes(span)).To(HaveKeyWithValue(cron.TracingAttributeScheduledTime, "2026-01-01T13:00:00Z"))
+		Expect(attributes(span)).To(HaveKey(cron.TracingAttributeRunID))
+	})
+})
@@ -3,11 +3,27 @@ package mocks
 
 import (
 	"sync"
+	"time"
 
 	"github.com/bborbe/cron"
 )
 
 type CronMetrics struct {
+	DecreaseRunningStub        func(string)
+	decreaseRunningMutex       sync.RWMutex
+	decreaseRunningArgsForCall []struct {
+		arg1 string
+	}
+	IncreaseAttemptStub        func(string)
+	increaseAttemptMutex       sync.RWMutex
+	increaseAttemptArgsForCall []struct {
+		arg1 string
+	}
+	IncreaseAttemptFailedStub        func(string)
+	increaseAttemptFailedMutex       sync.RWMutex
+	increaseAttemptFailedArgsForCall []struct {
+		arg1 string
+	}
 	IncreaseCompletedStub        func(string)
 	increaseCompletedMutex       sync.RWMutex
 	increaseCompletedArgsForCall []struct {
@@ -18,26 +34,191 @@ type CronMetrics struct {
 	increaseFailedArgsForCall []struct {
 		arg1 string
 	}
+	IncreaseLockSkippedStub        func(string)
+	increaseLockSkippedMutex       sync.RWMutex
+	increaseLockSkippedArgsForCall []struct {
+		arg1 string
+	}
+	IncreaseMissedStub        func(string)
+	increaseMissedMutex       sync.RWMutex
+	increaseMissedArgsForCall []struct {
+		arg1 string
+	}
+	IncreasePanicStub        func(string)
+	increasePanicMutex       sync.RWMutex
+	increasePanicArgsForCall []struct {
+		arg1 string
+	}
+	IncreaseQueuedStub        func(string)
+	increaseQueuedMutex       sync.RWMutex
+	increaseQueuedArgsForCall []struct {
+		arg1 string
+	}
+	IncreaseReplacedStub        func(string)
+	increaseReplacedMutex       sync.RWMutex
+	increaseReplacedArgsForCall []struct {
+		arg1 string
+	}
+	IncreaseRunningStub        func(string)
+	increaseRunningMutex       sync.RWMutex
+	increaseRunningArgsForCall []struct {
+		arg1 string
+	}
+	IncreaseSkippedStub        func(string)
+	increaseSkippedMutex       sync.RWMutex
+	increaseSkippedArgsForCall []struct {
+		arg1 string
+	}
 	IncreaseStartedStub        func(string)
 	increaseStartedMutex       sync.RWMutex
 	increaseStartedArgsForCall []struct {
 		arg1 string
 	}
+	IncreaseTimeoutStub        func(string)
+	increaseTimeoutMutex       sync.RWMutex
+	increaseTimeoutArgsForCall []struct {
+		arg1 string
+	}
+	ObserveDelayStub        func(string, float64)
+	observeDelayMutex       sync.RWMutex
+	observeDelayArgsForCall []struct {
+		arg1 string
+		arg2 float64
+	}
 	ObserveDurationStub        func(string, float64)
 	observeDurationMutex       sync.RWMutex
 	observeDurationArgsForCall []struct {
 		arg1 string
 		arg2 float64
 	}
+	SetJitterStub        func(string, float64)
+	setJitterMutex       sync.RWMutex
+	setJitterArgsForCall []struct {
+		arg1 string
+		arg2 float64
+	}
+	SetLastDurationStub        func(string, float64)
+	setLastDurationMutex       sync.RWMutex
+	setLastDurationArgsForCall []struct {
+		arg1 string
+		arg2 float64
+	}
+	SetLastFailureToCurrentStub        func(string)
+	setLastFailureToCurrentMutex       sync.RWMutex
+	setLastFailureToCurrentArgsForCall []struct {
+		arg1 string
+	}
 	SetLastSuccessToCurrentStub        func(string)
 	setLastSuccessToCurrentMutex       sync.RWMutex
 	setLastSuccessToCurrentArgsForCall []struct {
 		arg1 string
 	}
+	SetNextRunStub        func(string, time.Time)
+	setNextRunMutex       sync.RWMutex
+	setNextRunArgsForCall []struct {
+		arg1 string
+		arg2 time.Time
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
 
+func (fake *CronMetrics) DecreaseRunning(arg1 string) {
+	fake.decreaseRunningMutex.Lock()
+	fake.decreaseRunningArgsForCall = append(fake.decreaseRunningArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.DecreaseRunningStub
+	fake.recordInvocation("DecreaseRunning", []interface{}{arg1})
+	fake.decreaseRunningMutex.Unlock()
+	if stub != nil {
+		fake.DecreaseRunningStub(arg1)
+	}
+}
+
+func (fake *CronMetrics) DecreaseRunningCallCount() int {
+	fake.decreaseRunningMutex.RLock()
+	defer fake.decreaseRunningMutex.RUnlock()
+	return len(fake.decreaseRunningArgsForCall)
+}
+
+func (fake *CronMetrics) DecreaseRunningCalls(stub func(string)) {
+	fake.decreaseRunningMutex.Lock()
+	defer fake.decreaseRunningMutex.Unlock()
+	fake.DecreaseRunningStub = stub
+}
+
+func (fake *CronMetrics) DecreaseRunningArgsForCall(i int) string {
+	fake.decreaseRunningMutex.RLock()
+	defer fake.decreaseRunningMutex.RUnlock()
+	argsForCall := fake.decreaseRunningArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *CronMetrics) IncreaseAttempt(arg1 string) {
+	fake.increaseAttemptMutex.Lock()
+	fake.increaseAttemptArgsForCall = append(fake.increaseAttemptArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.IncreaseAttemptStub
+	fake.recordInvocation("IncreaseAttempt", []interface{}{arg1})
+	fake.increaseAttemptMutex.Unlock()
+	if stub != nil {
+		fake.IncreaseAttemptStub(arg1)
+	}
+}
+
+func (fake *CronMetrics) IncreaseAttemptCallCount() int {
+	fake.increaseAttemptMutex.RLock()
+	defer fake.increaseAttemptMutex.RUnlock()
+	return len(fake.increaseAttemptArgsForCall)
+}
+
+func (fake *CronMetrics) IncreaseAttemptCalls(stub func(string)) {
+	fake.increaseAttemptMutex.Lock()
+	defer fake.increaseAttemptMutex.Unlock()
+	fake.IncreaseAttemptStub = stub
+}
+
+func (fake *CronMetrics) IncreaseAttemptArgsForCall(i int) string {
+	fake.increaseAttemptMutex.RLock()
+	defer fake.increaseAttemptMutex.RUnlock()
+	argsForCall := fake.increaseAttemptArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *CronMetrics) IncreaseAttemptFailed(arg1 string) {
+	fake.increaseAttemptFailedMutex.Lock()
+	fake.increaseAttemptFailedArgsForCall = append(fake.increaseAttemptFailedArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.IncreaseAttemptFailedStub
+	fake.recordInvocation("IncreaseAttemptFailed", []interface{}{arg1})
+	fake.increaseAttemptFailedMutex.Unlock()
+	if stub != nil {
+		fake.IncreaseAttemptFailedStub(arg1)
+	}
+}
+
+func (fake *CronMetrics) IncreaseAttemptFailedCallCount() int {
+	fake.increaseAttemptFailedMutex.RLock()
+	defer fake.increaseAttemptFailedMutex.RUnlock()
+	return len(fake.increaseAttemptFailedArgsForCall)
+}
+
+func (fake *CronMetrics) IncreaseAttemptFailedCalls(stub func(string)) {
+	fake.increaseAttemptFailedMutex.Lock()
+	defer fake.increaseAttemptFailedMutex.Unlock()
+	fake.IncreaseAttemptFailedStub = stub
+}
+
+func (fake *CronMetrics) IncreaseAttemptFailedArgsForCall(i int) string {
+	fake.increaseAttemptFailedMutex.RLock()
+	defer fake.increaseAttemptFailedMutex.RUnlock()
+	argsForCall := fake.increaseAttemptFailedArgsForCall[i]
+	return argsForCall.arg1
+}
+
 func (fake *CronMetrics) IncreaseCompleted(arg1 string) {
 	fake.increaseCompletedMutex.Lock()
 	fake.increaseCompletedArgsForCall = append(fake.increaseCompletedArgsForCall, struct {
@@ -102,6 +283,230 @@ func (fake *CronMetrics) IncreaseFailedArgsForCall(i int) string {
 	return argsForCall.arg1
 }
 
+func (fake *CronMetrics) IncreaseLockSkipped(arg1 string) {
+	fake.increaseLockSkippedMutex.Lock()
+	fake.increaseLockSkippedArgsForCall = append(fake.increaseLockSkippedArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.IncreaseLockSkippedStub
+	fake.recordInvocation("IncreaseLockSkipped", []interface{}{arg1})
+	fake.increaseLockSkippedMutex.Unlock()
+	if stub != nil {
+		fake.IncreaseLockSkippedStub(arg1)
+	}
+}
+
+func (fake *CronMetrics) IncreaseLockSkippedCallCount() int {
+	fake.increaseLockSkippedMutex.RLock()
+	defer fake.increaseLockSkippedMutex.RUnlock()
+	return len(fake.increaseLockSkippedArgsForCall)
+}
+
+func (fake *CronMetrics) IncreaseLockSkippedCalls(stub func(string)) {
+	fake.increaseLockSkippedMutex.Lock()
+	defer fake.increaseLockSkippedMutex.Unlock()
+	fake.IncreaseLockSkippedStub = stub
+}
+
+func (fake *CronMetrics) IncreaseLockSkippedArgsForCall(i int) string {
+	fake.increaseLockSkippedMutex.RLock()
+	defer fake.increaseLockSkippedMutex.RUnlock()
+	argsForCall := fake.increaseLockSkippedArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *CronMetrics) IncreaseMissed(arg1 string) {
+	fake.increaseMissedMutex.Lock()
+	fake.increaseMissedArgsForCall = append(fake.increaseMissedArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.IncreaseMissedStub
+	fake.recordInvocation("IncreaseMissed", []interface{}{arg1})
+	fake.increaseMissedMutex.Unlock()
+	if stub != nil {
+		fake.IncreaseMissedStub(arg1)
+	}
+}
+
+func (fake *CronMetrics) IncreaseMissedCallCount() int {
+	fake.increaseMissedMutex.RLock()
+	defer fake.increaseMissedMutex.RUnlock()
+	return len(fake.increaseMissedArgsForCall)
+}
+
+func (fake *CronMetrics) IncreaseMissedCalls(stub func(string)) {
+	fake.increaseMissedMutex.Lock()
+	defer fake.increaseMissedMutex.Unlock()
+	fake.IncreaseMissedStub = stub
+}
+
+func (fake *CronMetrics) IncreaseMissedArgsForCall(i int) string {
+	fake.increaseMissedMutex.RLock()
+	defer fake.increaseMissedMutex.RUnlock()
+	argsForCall := fake.increaseMissedArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *CronMetrics) IncreasePanic(arg1 string) {
+	fake.increasePanicMutex.Lock()
+	fake.increasePanicArgsForCall = append(fake.increasePanicArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.IncreasePanicStub
+	fake.recordInvocation("IncreasePanic", []interface{}{arg1})
+	fake.increasePanicMutex.Unlock()
+	if stub != nil {
+		fake.IncreasePanicStub(arg1)
+	}
+}
+
+func (fake *CronMetrics) IncreasePanicCallCount() int {
+	fake.increasePanicMutex.RLock()
+	defer fake.increasePanicMutex.RUnlock()
+	return len(fake.increasePanicArgsForCall)
+}
+
+func (fake *CronMetrics) IncreasePanicCalls(stub func(string)) {
+	fake.increasePanicMutex.Lock()
+	defer fake.increasePanicMutex.Unlock()
+	fake.IncreasePanicStub = stub
+}
+
+func (fake *CronMetrics) IncreasePanicArgsForCall(i int) string {
+	fake.increasePanicMutex.RLock()
+	defer fake.increasePanicMutex.RUnlock()
+	argsForCall := fake.increasePanicArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *CronMetrics) IncreaseQueued(arg1 string) {
+	fake.increaseQueuedMutex.Lock()
+	fake.increaseQueuedArgsForCall = append(fake.increaseQueuedArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.IncreaseQueuedStub
+	fake.recordInvocation("IncreaseQueued", []interface{}{arg1})
+	fake.increaseQueuedMutex.Unlock()
+	if stub != nil {
+		fake.IncreaseQueuedStub(arg1)
+	}
+}
+
+func (fake *CronMetrics) IncreaseQueuedCallCount() int {
+	fake.increaseQueuedMutex.RLock()
+	defer fake.increaseQueuedMutex.RUnlock()
+	return len(fake.increaseQueuedArgsForCall)
+}
+
+func (fake *CronMetrics) IncreaseQueuedCalls(stub func(string)) {
+	fake.increaseQueuedMutex.Lock()
+	defer fake.increaseQueuedMutex.Unlock()
+	fake.IncreaseQueuedStub = stub
+}
+
+func (fake *CronMetrics) IncreaseQueuedArgsForCall(i int) string {
+	fake.increaseQueuedMutex.RLock()
+	defer fake.increaseQueuedMutex.RUnlock()
+	argsForCall := fake.increaseQueuedArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *CronMetrics) IncreaseReplaced(arg1 string) {
+	fake.increaseReplacedMutex.Lock()
+	fake.increaseReplacedArgsForCall = append(fake.increaseReplacedArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.IncreaseReplacedStub
+	fake.recordInvocation("IncreaseReplaced", []interface{}{arg1})
+	fake.increaseReplacedMutex.Unlock()
+	if stub != nil {
+		fake.IncreaseReplacedStub(arg1)
+	}
+}
+
+func (fake *CronMetrics) IncreaseReplacedCallCount() int {
+	fake.increaseReplacedMutex.RLock()
+	defer fake.increaseReplacedMutex.RUnlock()
+	return len(fake.increaseReplacedArgsForCall)
+}
+
+func (fake *CronMetrics) IncreaseReplacedCalls(stub func(string)) {
+	fake.increaseReplacedMutex.Lock()
+	defer fake.increaseReplacedMutex.Unlock()
+	fake.IncreaseReplacedStub = stub
+}
+
+func (fake *CronMetrics) IncreaseReplacedArgsForCall(i int) string {
+	fake.increaseReplacedMutex.RLock()
+	defer fake.increaseReplacedMutex.RUnlock()
+	argsForCall := fake.increaseReplacedArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *CronMetrics) IncreaseRunning(arg1 string) {
+	fake.increaseRunningMutex.Lock()
+	fake.increaseRunningArgsForCall = append(fake.increaseRunningArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.IncreaseRunningStub
+	fake.recordInvocation("IncreaseRunning", []interface{}{arg1})
+	fake.increaseRunningMutex.Unlock()
+	if stub != nil {
+		fake.IncreaseRunningStub(arg1)
+	}
+}
+
+func (fake *CronMetrics) IncreaseRunningCallCount() int {
+	fake.increaseRunningMutex.RLock()
+	defer fake.increaseRunningMutex.RUnlock()
+	return len(fake.increaseRunningArgsForCall)
+}
+
+func (fake *CronMetrics) IncreaseRunningCalls(stub func(string)) {
+	fake.increaseRunningMutex.Lock()
+	defer fake.increaseRunningMutex.Unlock()
+	fake.IncreaseRunningStub = stub
+}
+
+func (fake *CronMetrics) IncreaseRunningArgsForCall(i int) string {
+	fake.increaseRunningMutex.RLock()
+	defer fake.increaseRunningMutex.RUnlock()
+	argsForCall := fake.increaseRunningArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *CronMetrics) IncreaseSkipped(arg1 string) {
+	fake.increaseSkippedMutex.Lock()
+	fake.increaseSkippedArgsForCall = append(fake.increaseSkippedArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.IncreaseSkippedStub
+	fake.recordInvocation("IncreaseSkipped", []interface{}{arg1})
+	fake.increaseSkippedMutex.Unlock()
+	if stub != nil {
+		fake.IncreaseSkippedStub(arg1)
+	}
+}
+
+func (fake *CronMetrics) IncreaseSkippedCallCount() int {
+	fake.increaseSkippedMutex.RLock()
+	defer fake.increaseSkippedMutex.RUnlock()
+	return len(fake.increaseSkippedArgsForCall)
+}
+
+func (fake *CronMetrics) IncreaseSkippedCalls(stub func(string)) {
+	fake.increaseSkippedMutex.Lock()
+	defer fake.increaseSkippedMutex.Unlock()
+	fake.IncreaseSkippedStub = stub
+}
+
+func (fake *CronMetrics) IncreaseSkippedArgsForCall(i int) string {
+	fake.increaseSkippedMutex.RLock()
+	defer fake.increaseSkippedMutex.RUnlock()
+	argsForCall := fake.increaseSkippedArgsForCall[i]
+	return argsForCall.arg1
+}
+
 func (fake *CronMetrics) IncreaseStarted(arg1 string) {
 	fake.increaseStartedMutex.Lock()
 	fake.increaseStartedArgsForCall = append(fake.increaseStartedArgsForCall, struct {
@@ -134,6 +539,71 @@ func (fake *CronMetrics) IncreaseStartedArgsForCall(i int) string {
 	return argsForCall.arg1
 }
 
+func (fake *CronMetrics) IncreaseTimeout(arg1 string) {
+	fake.increaseTimeoutMutex.Lock()
+	fake.increaseTimeoutArgsForCall = append(fake.increaseTimeoutArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.IncreaseTimeoutStub
+	fake.recordInvocation("IncreaseTimeout", []interface{}{arg1})
+	fake.increaseTimeoutMutex.Unlock()
+	if stub != nil {
+		fake.IncreaseTimeoutStub(arg1)
+	}
+}
+
+func (fake *CronMetrics) IncreaseTimeoutCallCount() int {
+	fake.increaseTimeoutMutex.RLock()
+	defer fake.increaseTimeoutMutex.RUnlock()
+	return len(fake.increaseTimeoutArgsForCall)
+}
+
+func (fake *CronMetrics) IncreaseTimeoutCalls(stub func(string)) {
+	fake.increaseTimeoutMutex.Lock()
+	defer fake.increaseTimeoutMutex.Unlock()
+	fake.IncreaseTimeoutStub = stub
+}
+
+func (fake *CronMetrics) IncreaseTimeoutArgsForCall(i int) string {
+	fake.increaseTimeoutMutex.RLock()
+	defer fake.increaseTimeoutMutex.RUnlock()
+	argsForCall := fake.increaseTimeoutArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *CronMetrics) ObserveDelay(arg1 string, arg2 float64) {
+	fake.observeDelayMutex.Lock()
+	fake.observeDelayArgsForCall = append(fake.observeDelayArgsForCall, struct {
+		arg1 string
+		arg2 float64
+	}{arg1, arg2})
+	stub := fake.ObserveDelayStub
+	fake.recordInvocation("ObserveDelay", []interface{}{arg1, arg2})
+	fake.observeDelayMutex.Unlock()
+	if stub != nil {
+		fake.ObserveDelayStub(arg1, arg2)
+	}
+}
+
+func (fake *CronMetrics) ObserveDelayCallCount() int {
+	fake.observeDelayMutex.RLock()
+	defer fake.observeDelayMutex.RUnlock()
+	return len(fake.observeDelayArgsForCall)
+}
+
+func (fake *CronMetrics) ObserveDelayCalls(stub func(string, float64)) {
+	fake.observeDelayMutex.Lock()
+	defer fake.observeDelayMutex.Unlock()
+	fake.ObserveDelayStub = stub
+}
+
+func (fake *CronMetrics) ObserveDelayArgsForCall(i int) (string, float64) {
+	fake.observeDelayMutex.RLock()
+	defer fake.observeDelayMutex.RUnlock()
+	argsForCall := fake.observeDelayArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
 func (fake *CronMetrics) ObserveDuration(arg1 string, arg2 float64) {
 	fake.observeDurationMutex.Lock()
 	fake.observeDurationArgsForCall = append(fake.observeDurationArgsForCall, struct {
@@ -167,6 +637,104 @@ func (fake *CronMetrics) ObserveDurationArgsForCall(i int) (string, float64) {
 	return argsForCall.arg1, argsForCall.arg2
 }
 
+func (fake *CronMetrics) SetJitter(arg1 string, arg2 float64) {
+	fake.setJitterMutex.Lock()
+	fake.setJitterArgsForCall = append(fake.setJitterArgsForCall, struct {
+		arg1 string
+		arg2 float64
+	}{arg1, arg2})
+	stub := fake.SetJitterStub
+	fake.recordInvocation("SetJitter", []interface{}{arg1, arg2})
+	fake.setJitterMutex.Unlock()
+	if stub != nil {
+		fake.SetJitterStub(arg1, arg2)
+	}
+}
+
+func (fake *CronMetrics) SetJitterCallCount() int {
+	fake.setJitterMutex.RLock()
+	defer fake.setJitterMutex.RUnlock()
+	return len(fake.setJitterArgsForCall)
+}
+
+func (fake *CronMetrics) SetJitterCalls(stub func(string, float64)) {
+	fake.setJitterMutex.Lock()
+	defer fake.setJitterMutex.Unlock()
+	fake.SetJitterStub = stub
+}
+
+func (fake *CronMetrics) SetJitterArgsForCall(i int) (string, float64) {
+	fake.setJitterMutex.RLock()
+	defer fake.setJitterMutex.RUnlock()
+	argsForCall := fake.setJitterArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *CronMetrics) SetLastDuration(arg1 string, arg2 float64) {
+	fake.setLastDurationMutex.Lock()
+	fake.setLastDurationArgsForCall = append(fake.setLastDurationArgsForCall, struct {
+		arg1 string
+		arg2 float64
+	}{arg1, arg2})
+	stub := fake.SetLastDurationStub
+	fake.recordInvocation("SetLastDuration", []interface{}{arg1, arg2})
+	fake.setLastDurationMutex.Unlock()
+	if stub != nil {
+		fake.SetLastDurationStub(arg1, arg2)
+	}
+}
+
+func (fake *CronMetrics) SetLastDurationCallCount() int {
+	fake.setLastDurationMutex.RLock()
+	defer fake.setLastDurationMutex.RUnlock()
+	return len(fake.setLastDurationArgsForCall)
+}
+
+func (fake *CronMetrics) SetLastDurationCalls(stub func(string, float64)) {
+	fake.setLastDurationMutex.Lock()
+	defer fake.setLastDurationMutex.Unlock()
+	fake.SetLastDurationStub = stub
+}
+
+func (fake *CronMetrics) SetLastDurationArgsForCall(i int) (string, float64) {
+	fake.setLastDurationMutex.RLock()
+	defer fake.setLastDurationMutex.RUnlock()
+	argsForCall := fake.setLastDurationArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *CronMetrics) SetLastFailureToCurrent(arg1 string) {
+	fake.setLastFailureToCurrentMutex.Lock()
+	fake.setLastFailureToCurrentArgsForCall = append(fake.setLastFailureToCurrentArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.SetLastFailureToCurrentStub
+	fake.recordInvocation("SetLastFailureToCurrent", []interface{}{arg1})
+	fake.setLastFailureToCurrentMutex.Unlock()
+	if stub != nil {
+		fake.SetLastFailureToCurrentStub(arg1)
+	}
+}
+
+func (fake *CronMetrics) SetLastFailureToCurrentCallCount() int {
+	fake.setLastFailureToCurrentMutex.RLock()
+	defer fake.setLastFailureToCurrentMutex.RUnlock()
+	return len(fake.setLastFailureToCurrentArgsForCall)
+}
+
+func (fake *CronMetrics) SetLastFailureToCurrentCalls(stub func(string)) {
+	fake.setLastFailureToCurrentMutex.Lock()
+	defer fake.setLastFailureToCurrentMutex.Unlock()
+	fake.SetLastFailureToCurrentStub = stub
+}
+
+func (fake *CronMetrics) SetLastFailureToCurrentArgsForCall(i int) string {
+	fake.setLastFailureToCurrentMutex.RLock()
+	defer fake.setLastFailureToCurrentMutex.RUnlock()
+	argsForCall := fake.setLastFailureToCurrentArgsForCall[i]
+	return argsForCall.arg1
+}
+
 func (fake *CronMetrics) SetLastSuccessToCurrent(arg1 string) {
 	fake.setLastSuccessToCurrentMutex.Lock()
 	fake.setLastSuccessToCurrentArgsForCall = append(fake.setLastSuccessToCurrentArgsForCall, struct {
@@ -199,6 +767,39 @@ func (fake *CronMetrics) SetLastSuccessToCurrentArgsForCall(i int) string {
 	return argsForCall.arg1
 }
 
+func (fake *CronMetrics) SetNextRun(arg1 string, arg2 time.Time) {
+	fake.setNextRunMutex.Lock()
+	fake.setNextRunArgsForCall = append(fake.setNextRunArgsForCall, struct {
+		arg1 string
+		arg2 time.Time
+	}{arg1, arg2})
+	stub := fake.SetNextRunStub
+	fake.recordInvocation("SetNextRun", []interface{}{arg1, arg2})
+	fake.setNextRunMutex.Unlock()
+	if stub != nil {
+		fake.SetNextRunStub(arg1, arg2)
+	}
+}
+
+func (fake *CronMetrics) SetNextRunCallCount() int {
+	fake.setNextRunMutex.RLock()
+	defer fake.setNextRunMutex.RUnlock()
+	return len(fake.setNextRunArgsForCall)
+}
+
+func (fake *CronMetrics) SetNextRunCalls(stub func(string, time.Time)) {
+	fake.setNextRunMutex.Lock()
+	defer fake.setNextRunMutex.Unlock()
+	fake.SetNextRunStub = stub
+}
+
+func (fake *CronMetrics) SetNextRunArgsForCall(i int) (string, time.Time) {
+	fake.setNextRunMutex.RLock()
+	defer fake.setNextRunMutex.RUnlock()
+	argsForCall := fake.setNextRunArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
 func (fake *CronMetrics) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
@@ -221,4 +822,4 @@ func (fake *CronMetrics) recordInvocation(key string, args []interface{}) {
 	fake.invocations[key] = append(fake.invocations[key], args)
 }
 
-var _ cron.Metrics = new(CronMetrics)
+var _ cron.ExtendedMetrics = new(CronMetrics)